@@ -1,16 +1,22 @@
 package api
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/viper"
 	"github.com/zalando/go-keyring"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 )
 
+// clientTimeout is the connect timeout. Overall request timeout is disabled
+// by default and can be set with the `timeout` config, eg: timeout: 2m.
 const clientTimeout = 15 * time.Second
 
 var jiraClient *jira.Client
@@ -44,6 +50,24 @@ func Client(config jira.Config) *jira.Client {
 		authType := jira.AuthType(viper.GetString("auth_type"))
 		config.AuthType = &authType
 	}
+	if !config.DryRun {
+		config.DryRun = viper.GetBool("dry_run")
+	}
+	if !config.SkipNotify {
+		config.SkipNotify = viper.GetBool("no_notify")
+	}
+	if config.APIVersion == "" {
+		config.APIVersion = viper.GetString("api_version")
+	}
+	if config.ContextPath == "" {
+		config.ContextPath = viper.GetString("context_path")
+	}
+	if config.JiraAgileEndpoint == "" {
+		config.JiraAgileEndpoint = viper.GetString("agile_endpoint")
+	}
+	if config.DefaultMaxResults == 0 {
+		config.DefaultMaxResults = viper.GetInt("max_results")
+	}
 	if config.Insecure == nil {
 		insecure := viper.GetBool("insecure")
 		config.Insecure = &insecure
@@ -61,15 +85,53 @@ func Client(config jira.Config) *jira.Client {
 		config.MTLSConfig.ClientKey = viper.GetString("mtls.client_key")
 	}
 
-	jiraClient = jira.NewClient(
-		config,
-		jira.WithTimeout(clientTimeout),
+	opts := []jira.ClientFunc{
+		jira.WithConnectTimeout(clientTimeout),
+		jira.WithTimeout(viper.GetDuration("timeout")),
 		jira.WithInsecureTLS(*config.Insecure),
-	)
+		jira.WithDeprecationHandler(warnDeprecation),
+	}
+
+	// Trace requests to stderr in debug mode so that the command output stays intact.
+	// Bodies are included only if `debug_body` is set in the config, eg: debug_body: true.
+	if config.Debug || viper.GetBool("debug") {
+		opts = append(opts,
+			jira.WithDebug(os.Stderr),
+			jira.WithDebugBody(viper.GetBool("debug_body")),
+		)
+	}
+
+	jiraClient = jira.NewClient(config, opts...)
 
 	return jiraClient
 }
 
+// warnDeprecation warns users about endpoints that the server is going to remove
+// so that they get a heads-up before things break.
+func warnDeprecation(d jira.Deprecation) {
+	msg := fmt.Sprintf("Warning: %s %s is deprecated", d.Method, d.Path)
+	if d.Sunset != "" {
+		msg += fmt.Sprintf(" and will be removed after %s", d.Sunset)
+	}
+	if d.Warning != "" {
+		msg += fmt.Sprintf(": %s", d.Warning)
+	}
+	cmdutil.Warn("%s", msg)
+}
+
+// installationType returns the installation type set in the config. The type is detected
+// from the server if it is not configured, cloud is assumed if the detection fails.
+func installationType(c *jira.Client) string {
+	if it := viper.GetString("installation"); it != "" {
+		return it
+	}
+	it, err := c.InstallationType()
+	if err != nil {
+		return jira.InstallationTypeCloud
+	}
+	return it
+}
+
 // DefaultClient returns default jira client.
 func DefaultClient(debug bool) *jira.Client {
 	return Client(jira.Config{Debug: debug})
@@ -84,7 +146,7 @@ func ProxyCreate(c *jira.Client, cr *jira.CreateRequest) (*jira.CreateResponse,
 		err  error
 	)
 
-	it := viper.GetString("installation")
+	it := installationType(c)
 
 	if it == jira.InstallationTypeLocal {
 		resp, err = c.CreateV2(cr)
@@ -96,12 +158,12 @@ func ProxyCreate(c *jira.Client, cr *jira.CreateRequest) (*jira.CreateResponse,
 }
 
 // ProxyGetIssueRaw executes the same request as ProxyGetIssue but returns raw API response body string.
-func ProxyGetIssueRaw(c *jira.Client, key string) (string, error) {
-	it := viper.GetString("installation")
+func ProxyGetIssueRaw(c *jira.Client, key string, opts ...filter.Filter) (string, error) {
+	it := installationType(c)
 	if it == jira.InstallationTypeLocal {
-		return c.GetIssueV2Raw(key)
+		return c.GetIssueV2Raw(key, opts...)
 	}
-	return c.GetIssueRaw(key)
+	return c.GetIssueRaw(key, opts...)
 }
 
 // ProxyGetIssue uses either a v2 or v3 version of the Jira GET /issue/{key}
@@ -113,7 +175,7 @@ func ProxyGetIssue(c *jira.Client, key string, opts ...filter.Filter) (*jira.Iss
 		err error
 	)
 
-	it := viper.GetString("installation")
+	it := installationType(c)
 
 	if it == jira.InstallationTypeLocal {
 		iss, err = c.GetIssueV2(key, opts...)
@@ -124,21 +186,30 @@ func ProxyGetIssue(c *jira.Client, key string, opts ...filter.Filter) (*jira.Iss
 	return iss, err
 }
 
-// ProxySearch uses either a v2 or v3 version of the Jira GET /search endpoint
-// to search for the relevant issues based on configured installation type.
-// Defaults to v3 if installation type is not defined in the config.
+// ProxySearch uses either a v2 version of the Jira GET /search endpoint or the
+// GET /search/jql endpoint to search for the relevant issues based on configured
+// installation type. Defaults to /search/jql if installation type is not defined.
 func ProxySearch(c *jira.Client, jql string, from, limit uint) (*jira.SearchResult, error) {
+	return ProxySearchWithFields(c, jql, from, limit, nil)
+}
+
+// ProxySearchWithFields is the same as ProxySearch but only fetches the given fields of the issues.
+// All navigable fields are fetched if fields is empty.
+//
+// Cloud installations use GET /search/jql endpoint with cursor based pagination
+// as the /search endpoint is deprecated there.
+func ProxySearchWithFields(c *jira.Client, jql string, from, limit uint, fields []string) (*jira.SearchResult, error) {
 	var (
 		issues *jira.SearchResult
 		err    error
 	)
 
-	it := viper.GetString("installation")
+	it := installationType(c)
 
 	if it == jira.InstallationTypeLocal {
-		issues, err = c.SearchV2(jql, from, limit)
+		issues, err = c.SearchV2WithFields(jql, from, limit, fields)
 	} else {
-		issues, err = c.Search(jql, from, limit)
+		issues, err = c.SearchJQLFrom(jql, from, limit, fields)
 	}
 
 	return issues, err
@@ -148,7 +219,7 @@ func ProxySearch(c *jira.Client, jql string, from, limit uint) (*jira.SearchResu
 // endpoint to assign an issue to the user.
 // Defaults to v3 if installation type is not defined in the config.
 func ProxyAssignIssue(c *jira.Client, key string, user *jira.User, def string) error {
-	it := viper.GetString("installation")
+	it := installationType(c)
 	assignee := def
 
 	if user != nil {
@@ -166,6 +237,28 @@ func ProxyAssignIssue(c *jira.Client, key string, user *jira.User, def string) e
 	return c.AssignIssue(key, assignee)
 }
 
+// ProxyBulkAssign uses either v2 or v3 version of the PUT /issue/{key}/assignee
+// endpoint to assign multiple issues to the user.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyBulkAssign(ctx context.Context, c *jira.Client, keys []string, user *jira.User, def string) []jira.BulkResult {
+	it := installationType(c)
+	assignee := def
+
+	if user != nil {
+		switch it {
+		case jira.InstallationTypeLocal:
+			assignee = user.Name
+		default:
+			assignee = user.AccountID
+		}
+	}
+
+	if it == jira.InstallationTypeLocal {
+		return c.BulkAssignV2(ctx, keys, assignee)
+	}
+	return c.BulkAssign(ctx, keys, assignee)
+}
+
 // ProxyUserSearch uses either v2 or v3 version of the GET /user/assignable/search
 // endpoint to search for the users assignable to the given issue.
 // Defaults to v3 if installation type is not defined in the config.
@@ -175,7 +268,7 @@ func ProxyUserSearch(c *jira.Client, opts *jira.UserSearchOptions) ([]*jira.User
 		err   error
 	)
 
-	it := viper.GetString("installation")
+	it := installationType(c)
 
 	if it == jira.InstallationTypeLocal {
 		users, err = c.UserSearchV2(opts)
@@ -195,7 +288,7 @@ func ProxyTransitions(c *jira.Client, key string) ([]*jira.Transition, error) {
 		err         error
 	)
 
-	it := viper.GetString("installation")
+	it := installationType(c)
 
 	if it == jira.InstallationTypeLocal {
 		transitions, err = c.TransitionsV2(key)
@@ -210,7 +303,7 @@ func ProxyTransitions(c *jira.Client, key string) ([]*jira.Transition, error) {
 // endpoint to assign an issue to the user. Defaults to v3 if installation type is
 // not defined in the config.
 func ProxyWatchIssue(c *jira.Client, key string, user *jira.User) error {
-	it := viper.GetString("installation")
+	it := installationType(c)
 
 	var assignee string
 
@@ -228,3 +321,65 @@ func ProxyWatchIssue(c *jira.Client, key string, user *jira.User) error {
 	}
 	return c.WatchIssue(key, assignee)
 }
+
+// ProxyBulkWatch uses either v2 or v3 version of the POST /issue/{key}/watchers
+// endpoint to add the user as a watcher of multiple issues.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyBulkWatch(ctx context.Context, c *jira.Client, keys []string, user *jira.User) []jira.BulkResult {
+	if installationType(c) == jira.InstallationTypeLocal {
+		return c.BulkWatchV2(ctx, keys, user.Name)
+	}
+	return c.BulkWatch(ctx, keys, user.AccountID)
+}
+
+// ProxyBulkUnwatch uses either v2 or v3 version of the DELETE /issue/{key}/watchers
+// endpoint to remove the user from watchers of multiple issues.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyBulkUnwatch(ctx context.Context, c *jira.Client, keys []string, user *jira.User) []jira.BulkResult {
+	if installationType(c) == jira.InstallationTypeLocal {
+		return c.BulkUnwatchV2(ctx, keys, user.Name)
+	}
+	return c.BulkUnwatch(ctx, keys, user.AccountID)
+}
+
+// ProxySearchUsers uses either v2 or v3 version of the GET /user/search
+// endpoint to search for users matching the given query.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxySearchUsers(c *jira.Client, query string, maxResults int) ([]*jira.User, error) {
+	it := installationType(c)
+	if it == jira.InstallationTypeLocal {
+		return c.SearchUsersV2(query, maxResults)
+	}
+	return c.SearchUsers(query, maxResults)
+}
+
+// ProxySearchAll uses either v2 or v3 version of the search endpoints to fetch every
+// issue matching the JQL based on configured installation type.
+func ProxySearchAll(c *jira.Client, jql string, fields []string) ([]*jira.Issue, error) {
+	it := installationType(c)
+	if it == jira.InstallationTypeLocal {
+		return c.SearchAllV2(jql, fields)
+	}
+	return c.SearchAll(jql, fields)
+}
+
+// ProxyGetGroupMembers uses either v2 or v3 version of the GET /group/member
+// endpoint to fetch members of a group based on configured installation type.
+func ProxyGetGroupMembers(c *jira.Client, group string) ([]*jira.User, error) {
+	it := installationType(c)
+	if it == jira.InstallationTypeLocal {
+		return c.GetGroupMembersV2(group)
+	}
+	return c.GetGroupMembers(group)
+}
+
+// ProxyGetFilters fetches saved filters using GET /filter/search endpoint for
+// cloud installations. The endpoint is not available in on-premise installations,
+// so favourite filters from GET /filter/favourite are returned instead.
+func ProxyGetFilters(c *jira.Client) ([]*jira.Filter, error) {
+	it := installationType(c)
+	if it == jira.InstallationTypeLocal {
+		return c.GetFavouriteFilters()
+	}
+	return c.GetFilters()
+}
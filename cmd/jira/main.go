@@ -1,16 +1,14 @@
 package main
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/root"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 )
 
 func main() {
 	rootCmd := root.NewCmdRoot()
 	if _, err := rootCmd.ExecuteC(); err != nil {
-		fmt.Fprintf(os.Stderr, "%s\n", err)
-		os.Exit(1)
+		// Errors returned by cobra itself are about unknown commands, flags or arguments.
+		cmdutil.ExitIfError(&cmdutil.ValidationError{Msg: err.Error()})
 	}
 }
@@ -0,0 +1,30 @@
+package dashboard
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/dashboard/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/dashboard/open"
+)
+
+const helpText = `Dashboard lists Jira dashboards and opens them in a browser. See available commands below.`
+
+// NewCmdDashboard is a dashboard command.
+func NewCmdDashboard() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "dashboard",
+		Short:       "Dashboard lists and opens Jira dashboards",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"dashboards"},
+		RunE:        dashboards,
+	}
+
+	cmd.AddCommand(list.NewCmdList(), open.NewCmdOpen())
+
+	return &cmd
+}
+
+func dashboards(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
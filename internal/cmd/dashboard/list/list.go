@@ -0,0 +1,61 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira dashboard list
+
+# List the second page of 20 dashboards
+$ jira dashboard list --paginate 20:20`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists Jira dashboards",
+		Long:    "List lists Jira dashboards that a user has access to.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
+
+	return &cmd
+}
+
+// List displays a list view.
+func List(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	paginate, err := cmd.Flags().GetString("paginate")
+	cmdutil.ExitIfError(err)
+
+	from, limit, err := query.GetPaginateParams(paginate)
+	cmdutil.ExitIfError(err)
+
+	res, err := func() (*jira.DashboardList, error) {
+		s := cmdutil.Info("Fetching dashboards...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetDashboards(int(from), int(limit))
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(res.Dashboards) == 0 {
+		cmdutil.Failed("No dashboards found.")
+		return
+	}
+
+	v := view.NewDashboard(res.Dashboards)
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,52 @@
+package open
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+)
+
+const (
+	helpText = `Open opens a dashboard in a browser. Use "jira dashboard list" to find the dashboard id.`
+	examples = `$ jira dashboard open 10000
+
+# Only print the dashboard URL
+$ jira dashboard open 10000 --no-browser`
+)
+
+// NewCmdOpen is an open command.
+func NewCmdOpen() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "open DASHBOARD-ID",
+		Short:   "Open opens a dashboard in a browser",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"browse", "navigate"},
+		Annotations: map[string]string{
+			"help:args": "DASHBOARD-ID\tDashboard id, eg: 10000",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  open,
+	}
+
+	cmd.Flags().BoolP("no-browser", "n", false, `Skip opening destination URL in the browser`)
+
+	return &cmd
+}
+
+func open(cmd *cobra.Command, args []string) {
+	url := cmdutil.GenerateServerDashboardURL(viper.GetString("server"), args[0])
+
+	fmt.Println(url)
+
+	noBrowser, err := cmd.Flags().GetBool("no-browser")
+	cmdutil.ExitIfError(err)
+
+	if !noBrowser {
+		cmdutil.ExitIfError(browser.Browse(url))
+	}
+}
@@ -1,7 +1,6 @@
 package add
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -16,7 +15,10 @@ import (
 
 const (
 	helpText = `Add issues to an epic.`
-	examples = `$ jira epic add EPIC-KEY ISSUE-1 ISSUE-2`
+	examples = `$ jira epic add EPIC-KEY ISSUE-1 ISSUE-2
+
+# Issues can also be passed as a comma separated list
+$ jira epic add EPIC-KEY ISSUE-1,ISSUE-2`
 )
 
 // NewCmdAdd is an add command.
@@ -38,7 +40,6 @@ func NewCmdAdd() *cobra.Command {
 func add(cmd *cobra.Command, args []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 	params := parseFlags(cmd.Flags(), args, project)
 	client := api.DefaultClient(params.debug)
 
@@ -64,49 +65,22 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	var (
-		failed strings.Builder
-		passed bool
-	)
-
-	err := func() error {
+	results, err := func() ([]jira.BulkResult, error) {
 		s := cmdutil.Info("Adding issues to the epic...")
 		defer s.Stop()
 
-		if projectType != jira.ProjectTypeNextGen {
-			return client.EpicIssuesAdd(params.epicKey, params.issues...)
-		}
-
-		// If the project is of the next-gen type, we need to set the parent property for each issue.
-		// There is no way to send bulk update requests as of now, so we need to send these requests
-		// in a loop. We will print failed requests with exit code 1 at the end if there are any.
-		for _, iss := range params.issues {
-			if err := client.Edit(iss, &jira.EditRequest{ParentIssueKey: params.epicKey, SkipNotify: true}); err != nil {
-				msg := fmt.Sprintf("\n  - %s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
-				failed.WriteString(msg)
-			} else {
-				// We will show success message if at-least one request reports success.
-				passed = true
-			}
-		}
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
 
-		if failed.Len() > 0 {
-			return &jira.ErrMultipleFailed{Msg: failed.String()}
-		}
-		return nil
+		return client.AddIssuesToEpic(ctx, params.epicKey, params.issues)
 	}()
+	cmdutil.ExitIfError(err)
 
-	msg := fmt.Sprintf("Issues added to the epic %s\n%s", params.epicKey, cmdutil.GenerateServerBrowseURL(server, params.epicKey))
-
-	if projectType != jira.ProjectTypeNextGen {
-		cmdutil.ExitIfError(err)
-		cmdutil.Success(msg)
-	} else {
-		if passed {
-			cmdutil.Success(msg)
-		}
-		cmdutil.ExitIfError(err)
+	passed, err := cmdutil.BulkError(results)
+	if passed > 0 {
+		cmdutil.Success("Issues added to the epic %s\n%s", params.epicKey, cmdutil.GenerateServerBrowseURL(server, params.epicKey))
 	}
+	cmdutil.ExitIfError(err)
 }
 
 func parseFlags(flags query.FlagParser, args []string, project string) *addParams {
@@ -122,8 +96,13 @@ func parseFlags(flags query.FlagParser, args []string, project string) *addParam
 	if nArgs > 1 {
 		tickets := args[1:]
 		issues = make([]string, 0, len(tickets))
-		for _, iss := range tickets {
-			issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+		for _, t := range tickets {
+			// Issues can also be passed as a comma separated list, eg: ISSUE-1,ISSUE-2.
+			for _, iss := range strings.Split(t, ",") {
+				if iss = strings.TrimSpace(iss); iss != "" {
+					issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+				}
+			}
 		}
 	}
 
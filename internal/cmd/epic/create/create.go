@@ -92,6 +92,7 @@ func create(cmd *cobra.Command, _ []string) {
 
 	params.Reporter = cmdcommon.GetRelevantUser(client, project, params.Reporter)
 	params.Assignee = cmdcommon.GetRelevantUser(client, project, params.Assignee)
+	params.SecurityLevel = cmdcommon.GetSecurityLevelID(client, project, params.SecurityLevel)
 
 	key, err := func() (string, error) {
 		s := cmdutil.Info("Creating an epic...")
@@ -109,6 +110,7 @@ func create(cmd *cobra.Command, _ []string) {
 			Components:      params.Components,
 			FixVersions:     params.FixVersions,
 			AffectsVersions: params.AffectsVersions,
+			SecurityLevel:   params.SecurityLevel,
 			CustomFields:    params.CustomFields,
 			EpicField:       viper.GetString("epic.name"),
 		}
@@ -166,6 +168,13 @@ func (cc *createCmd) getQuestions(projectType string) []*survey.Question {
 		defaultBody = string(b)
 	}
 
+	if cc.params.Editor && cc.params.Body == "" {
+		body, err := surveyext.Edit(defaultBody)
+		cmdutil.ExitIfError(err)
+
+		cc.params.Body = body
+	}
+
 	if cc.params.NoInput {
 		if cc.params.Body == "" {
 			cc.params.Body = defaultBody
@@ -214,6 +223,9 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 	body, err := flags.GetString("body")
 	cmdutil.ExitIfError(err)
 
+	body, err = cmdutil.ReadBodyInput(body)
+	cmdutil.ExitIfError(err)
+
 	priority, err := flags.GetString("priority")
 	cmdutil.ExitIfError(err)
 
@@ -235,12 +247,18 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 	affectsVersions, err := flags.GetStringArray("affects-version")
 	cmdutil.ExitIfError(err)
 
+	securityLevel, err := flags.GetString("security")
+	cmdutil.ExitIfError(err)
+
 	custom, err := flags.GetStringToString("custom")
 	cmdutil.ExitIfError(err)
 
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	editor, err := flags.GetBool("editor")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -258,8 +276,10 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 		Components:      components,
 		FixVersions:     fixVersions,
 		AffectsVersions: affectsVersions,
+		SecurityLevel:   securityLevel,
 		CustomFields:    custom,
 		Template:        template,
+		Editor:          editor,
 		NoInput:         noInput,
 		Debug:           debug,
 	}
@@ -37,6 +37,10 @@ $ jira epic list <KEY> --plain
 $ jira epic list --table --plain --no-headers
 $ jira epic list <KEY> --plain --no-headers
 
+# Narrow down epic issues by status or with an additional JQL filter
+$ jira epic list <KEY> -s"In Progress"
+$ jira epic list <KEY> -q"assignee = currentUser()"
+
 # Display some columns of epic or epic issues in a plain table view
 $ jira epic list --table --plain --columns key,summary,status
 $ jira epic list <KEY> --plain --columns type,key,summary`
@@ -106,7 +110,7 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 			q.Params().Parent = key
 			q.Params().IssueType = ""
 
-			resp, err = client.Search(q.Get(), q.Params().From, q.Params().Limit)
+			resp, err = api.ProxySearch(client, q.Get(), q.Params().From, q.Params().Limit)
 		} else {
 			resp, err = client.EpicIssues(key, q.Get(), q.Params().From, q.Params().Limit)
 		}
@@ -136,6 +140,9 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	noTruncate, err := flags.GetBool("no-truncate")
 	cmdutil.ExitIfError(err)
 
+	maxColWidth, err := flags.GetUint("max-col-width")
+	cmdutil.ExitIfError(err)
+
 	fixedColumns, err := flags.GetUint("fixed-columns")
 	cmdutil.ExitIfError(err)
 
@@ -155,6 +162,7 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 			CSV:          csv,
 			NoHeaders:    noHeaders,
 			NoTruncate:   noTruncate,
+			MaxColWidth:  maxColWidth,
 			FixedColumns: fixedColumns,
 			Columns: func() []string {
 				if columns != "" {
@@ -164,6 +172,7 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			DateFormat: viper.GetString("date_format"),
 		},
 	}
 
@@ -195,6 +204,9 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 	fixedColumns, err := flags.GetUint("fixed-columns")
 	cmdutil.ExitIfError(err)
 
+	maxColWidth, err := flags.GetUint("max-col-width")
+	cmdutil.ExitIfError(err)
+
 	v := view.EpicList{
 		Total:   total,
 		Project: project,
@@ -207,7 +219,7 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 				q.Params().Parent = key
 				q.Params().IssueType = ""
 
-				resp, err = client.Search(q.Get(), q.Params().From, q.Params().Limit)
+				resp, err = api.ProxySearch(client, q.Get(), q.Params().From, q.Params().Limit)
 			} else {
 				resp, err = client.EpicIssues(key, "", q.Params().From, q.Params().Limit)
 			}
@@ -218,8 +230,10 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 		},
 		Display: view.DisplayFormat{
 			FixedColumns: fixedColumns,
+			MaxColWidth:  maxColWidth,
 			TableStyle:   cmdutil.GetTUIStyleConfig(),
 			Timezone:     viper.GetString("timezone"),
+			DateFormat:   viper.GetString("date_format"),
 		},
 	}
 
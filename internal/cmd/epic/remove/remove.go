@@ -1,7 +1,6 @@
 package remove
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -16,7 +15,10 @@ import (
 
 const (
 	helpText = `Remove/unassign epic from issues.`
-	examples = `$ jira epic remove ISSUE-1 ISSUE-2`
+	examples = `$ jira epic remove ISSUE-1 ISSUE-2
+
+# Issues can also be passed as a comma separated list
+$ jira epic remove ISSUE-1,ISSUE-2`
 )
 
 // NewCmdRemove is a remove command.
@@ -36,7 +38,6 @@ func NewCmdRemove() *cobra.Command {
 
 func remove(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 	params := parseFlags(cmd.Flags(), args, project)
 	client := api.DefaultClient(params.debug)
 
@@ -58,53 +59,34 @@ func remove(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	var (
-		failed strings.Builder
-		passed bool
-	)
-
-	err := func() error {
+	results, err := func() ([]jira.BulkResult, error) {
 		s := cmdutil.Info("Removing assigned epic from issues...")
 		defer s.Stop()
 
-		if projectType != jira.ProjectTypeNextGen {
-			return client.EpicIssuesRemove(params.issues...)
-		}
-
-		for _, iss := range params.issues {
-			if err := client.Edit(iss, &jira.EditRequest{ParentIssueKey: jira.AssigneeNone, SkipNotify: true}); err != nil {
-				msg := fmt.Sprintf("\n  - %s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
-				failed.WriteString(msg)
-			} else {
-				// We will show success message if at-least one request reports success.
-				passed = true
-			}
-		}
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
 
-		if failed.Len() > 0 {
-			return &jira.ErrMultipleFailed{Msg: failed.String()}
-		}
-		return nil
+		return client.RemoveIssuesFromEpic(ctx, params.issues)
 	}()
+	cmdutil.ExitIfError(err)
 
-	msg := "Epic unassigned from given issues"
-
-	if projectType != jira.ProjectTypeNextGen {
-		cmdutil.ExitIfError(err)
-		cmdutil.Success(msg)
-	} else {
-		if passed {
-			cmdutil.Success(msg)
-		}
-		cmdutil.ExitIfError(err)
+	passed, err := cmdutil.BulkError(results)
+	if passed > 0 {
+		cmdutil.Success("Epic unassigned from given issues")
 	}
+	cmdutil.ExitIfError(err)
 }
 
 func parseFlags(flags query.FlagParser, args []string, project string) *removeParams {
 	tickets := args[0:]
 	issues := make([]string, 0, len(tickets))
-	for _, iss := range tickets {
-		issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+	for _, t := range tickets {
+		// Issues can also be passed as a comma separated list, eg: ISSUE-1,ISSUE-2.
+		for _, iss := range strings.Split(t, ",") {
+			if iss = strings.TrimSpace(iss); iss != "" {
+				issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+			}
+		}
 	}
 
 	debug, err := flags.GetBool("debug")
@@ -0,0 +1,79 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create saves a JQL query as a filter so that it can be shared and reused.`
+	examples = `$ jira filter create "Team bugs" "project = TEST AND type = Bug"
+
+# Create a filter and mark it as favourite
+$ jira filter create "My open issues" "assignee = currentUser() AND resolution = Unresolved" --favourite`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME JQL",
+		Short:   "Create a saved filter",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the filter, eg: \"Team bugs\"\n" +
+				"JQL\tJQL query of the filter, eg: \"project = TEST AND type = Bug\"",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  create,
+	}
+
+	cmd.Flags().Bool("favourite", false, "Mark the filter as favourite")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+
+	filter, err := func() (*jira.Filter, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating filter %q...", params.name))
+		defer s.Stop()
+
+		return api.DefaultClient(params.debug).CreateFilter(params.name, params.jql, params.favourite)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Filter %q created with id %s", filter.Name, filter.ID)
+	if filter.ViewURL != "" {
+		fmt.Printf("%s\n", filter.ViewURL)
+	}
+}
+
+func parseFlags(flags query.FlagParser, args []string) *createParams {
+	favourite, err := flags.GetBool("favourite")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		name:      args[0],
+		jql:       args[1],
+		favourite: favourite,
+		debug:     debug,
+	}
+}
+
+type createParams struct {
+	name      string
+	jql       string
+	favourite bool
+	debug     bool
+}
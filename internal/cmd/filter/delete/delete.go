@@ -0,0 +1,91 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+)
+
+const (
+	helpText = `Delete deletes a saved filter. Only the owner of the filter can delete it.
+
+You will be asked to confirm the deletion unless the '--force' flag is passed.`
+	examples = `$ jira filter delete 10020
+
+# Delete without confirmation
+$ jira filter delete 10020 --force`
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete FILTER-ID",
+		Short:   "Delete a saved filter",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "FILTER-ID\tID of the filter, eg: 10020",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  del,
+	}
+
+	cmd.Flags().Bool("force", false, "Delete filter without confirmation")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+
+	if !params.force && !confirm(params.id) {
+		cmdutil.Failed("Action aborted, use '--force' to delete without confirmation")
+	}
+
+	err := func() error {
+		s := cmdutil.Info(fmt.Sprintf("Removing filter %s", params.id))
+		defer s.Stop()
+
+		return api.DefaultClient(params.debug).DeleteFilter(params.id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Filter %s removed successfully", params.id)
+}
+
+type deleteParams struct {
+	id    string
+	force bool
+	debug bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *deleteParams {
+	force, err := flags.GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &deleteParams{
+		id:    args[0],
+		force: force,
+		debug: debug,
+	}
+}
+
+func confirm(id string) bool {
+	var ans bool
+
+	prompt := &survey.Confirm{Message: fmt.Sprintf("Are you sure you want to delete filter %s?", id)}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		return false
+	}
+
+	return ans
+}
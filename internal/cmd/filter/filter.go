@@ -0,0 +1,31 @@
+package filter
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/list"
+)
+
+const helpText = `Filter manages saved Jira filters. See available commands below.`
+
+// NewCmdFilter is a filter command.
+func NewCmdFilter() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "filter",
+		Short:       "Filter manages saved Jira filters",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Aliases:     []string{"filters"},
+		RunE:        filters,
+	}
+
+	cmd.AddCommand(list.NewCmdList(), create.NewCmdCreate(), delete.NewCmdDelete())
+
+	return &cmd
+}
+
+func filters(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
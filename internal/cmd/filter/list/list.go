@@ -0,0 +1,48 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const helpText = `List lists saved filters that a user has access to.
+
+On-premise installations only list filters marked as favourite by the user.`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists saved Jira filters",
+		Long:    helpText,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+}
+
+// List displays a list view.
+func List(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	filters, err := func() ([]*jira.Filter, error) {
+		s := cmdutil.Info("Fetching filters...")
+		defer s.Stop()
+
+		return api.ProxyGetFilters(api.DefaultClient(debug))
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(filters) == 0 {
+		cmdutil.Failed("No filters found.")
+		return
+	}
+
+	v := view.NewFilter(filters)
+
+	cmdutil.ExitIfError(v.Render())
+}
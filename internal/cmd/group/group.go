@@ -0,0 +1,29 @@
+package group
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group/members"
+)
+
+const helpText = `Group looks up Jira user groups. See available commands below.`
+
+// NewCmdGroup is a group command.
+func NewCmdGroup() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "group",
+		Short:       "Group looks up Jira user groups",
+		Long:        helpText,
+		Aliases:     []string{"groups"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        group,
+	}
+
+	cmd.AddCommand(members.NewCmdMembers())
+
+	return &cmd
+}
+
+func group(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
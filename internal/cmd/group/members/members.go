@@ -0,0 +1,61 @@
+package members
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Members lists users that belong to a group.
+
+Group names are what Jira expects when restricting comment visibility to a group.`
+	examples = `$ jira group members developers
+
+# Group names with spaces need to be quoted
+$ jira group members "Core developers"`
+)
+
+// NewCmdMembers is a members command.
+func NewCmdMembers() *cobra.Command {
+	return &cobra.Command{
+		Use:     "members GROUP",
+		Short:   "Members lists users that belong to a group",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"member"},
+		Annotations: map[string]string{
+			"help:args": "GROUP\tName of the group, eg: developers",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  members,
+	}
+}
+
+func members(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	users, err := func() ([]*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching members of group %q...", args[0]))
+		defer s.Stop()
+
+		return api.ProxyGetGroupMembers(api.DefaultClient(debug), args[0])
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(users) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No members found in group %q", args[0])
+		return
+	}
+
+	v := view.NewUser(users)
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -15,14 +15,15 @@ import (
 )
 
 type initParams struct {
-	installation string
-	server       string
-	login        string
-	authType     string
-	project      string
-	board        string
-	force        bool
-	insecure     bool
+	installation  string
+	server        string
+	agileEndpoint string
+	login         string
+	authType      string
+	project       string
+	board         string
+	force         bool
+	insecure      bool
 }
 
 // NewCmdInit is an init command.
@@ -39,6 +40,7 @@ func NewCmdInit() *cobra.Command {
 
 	cmd.Flags().String("installation", "", "Is this a 'cloud' or 'local' jira installation?")
 	cmd.Flags().String("server", "", "Link to your jira server")
+	cmd.Flags().String("agile-endpoint", "", "Link to the Jira Agile API if it isn't served from the server, defaults to the server")
 	cmd.Flags().String("login", "", "Jira login username or email based on your setup")
 	cmd.Flags().String("auth-type", "", "Authentication type can be basic, bearer or mtls")
 	cmd.Flags().String("project", "", "Your default project key")
@@ -57,6 +59,9 @@ func parseFlags(flags query.FlagParser) *initParams {
 	server, err := flags.GetString("server")
 	cmdutil.ExitIfError(err)
 
+	agileEndpoint, err := flags.GetString("agile-endpoint")
+	cmdutil.ExitIfError(err)
+
 	login, err := flags.GetString("login")
 	cmdutil.ExitIfError(err)
 
@@ -82,14 +87,15 @@ func parseFlags(flags query.FlagParser) *initParams {
 	cmdutil.ExitIfError(err)
 
 	return &initParams{
-		installation: installation,
-		server:       server,
-		login:        login,
-		authType:     authType,
-		project:      project,
-		board:        board,
-		force:        force,
-		insecure:     insecure,
+		installation:  installation,
+		server:        server,
+		agileEndpoint: agileEndpoint,
+		login:         login,
+		authType:      authType,
+		project:       project,
+		board:         board,
+		force:         force,
+		insecure:      insecure,
 	}
 }
 
@@ -98,14 +104,15 @@ func initialize(cmd *cobra.Command, _ []string) {
 
 	c := jiraConfig.NewJiraCLIConfigGenerator(
 		&jiraConfig.JiraCLIConfig{
-			Installation: strings.ToLower(params.installation),
-			Server:       params.server,
-			Login:        params.login,
-			AuthType:     params.authType,
-			Project:      params.project,
-			Board:        params.board,
-			Force:        params.force,
-			Insecure:     params.insecure,
+			Installation:  strings.ToLower(params.installation),
+			Server:        params.server,
+			AgileEndpoint: params.agileEndpoint,
+			Login:         params.login,
+			AuthType:      params.authType,
+			Project:       params.project,
+			Board:         params.board,
+			Force:         params.force,
+			Insecure:      params.insecure,
 		},
 	)
 
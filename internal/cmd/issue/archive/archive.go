@@ -0,0 +1,68 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Archive archives issues so that they no longer show up in searches and boards.
+
+Archived issues can be brought back with 'jira issue restore'. Archiving is only
+available in Jira cloud Premium and Enterprise plans.`
+	examples = `$ jira issue archive ISSUE-1
+
+# Archive multiple issues at once
+$ jira issue archive ISSUE-1,ISSUE-2`
+)
+
+// NewCmdArchive is an archive command.
+func NewCmdArchive() *cobra.Command {
+	return &cobra.Command{
+		Use:     "archive ISSUE-KEY",
+		Short:   "Archive issues",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1, or a comma separated list of keys, eg: ISSUE-1,ISSUE-2",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               archive,
+	}
+}
+
+func archive(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	keys := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	if len(keys) == 0 {
+		cmdutil.Failed("Issue key is required")
+	}
+	cmdutil.ExitIfError(cmdutil.ValidateIssueKeys(keys...))
+
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Archiving %d issues...", len(keys)))
+		defer s.Stop()
+
+		return client.ArchiveIssues(keys)
+	}()
+	if errors.Is(err, jira.ErrPremiumRequired) {
+		cmdutil.Failed("Unable to archive issues: archiving is only available in Jira cloud Premium and Enterprise plans")
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Archived issues: %s", strings.Join(keys, ", "))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -25,13 +26,16 @@ const (
 $ jira issue assign ISSUE-1 "Jon Doe"
 
 # Assign to self
-$ jira issue assign ISSUE-1 $(jira me)
+$ jira issue assign ISSUE-1 --me
 
 # Assign to default assignee
 $ jira issue assign ISSUE-1 default
 
 # Unassign
-$ jira issue assign ISSUE-1 x`
+$ jira issue assign ISSUE-1 x
+
+# Assign multiple issues at once
+$ jira issue assign ISSUE-1,ISSUE-2,ISSUE-3 "Jon Doe"`
 
 	maxResults = 100
 	lineBreak  = "----------"
@@ -44,18 +48,23 @@ $ jira issue assign ISSUE-1 x`
 
 // NewCmdAssign is an assign command.
 func NewCmdAssign() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "assign ISSUE-KEY ASSIGNEE",
 		Short:   "Assign issue to a user",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"asg"},
 		Annotations: map[string]string{
-			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1, or a comma separated list of keys, eg: ISSUE-1,ISSUE-2
 ASSIGNEE	Email or display name of the user to assign the issue to`,
 		},
-		Run: assign,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               assign,
 	}
+
+	cmd.Flags().Bool("me", false, "Assign issue to the current user")
+
+	return &cmd
 }
 
 func assign(cmd *cobra.Command, args []string) {
@@ -71,7 +80,11 @@ func assign(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(ac.setIssueKey(project))
 
-	if lu != strings.ToLower(optionNone) && lu != "x" && lu != jira.AssigneeDefault {
+	if ac.params.me {
+		cmdutil.ExitIfError(ac.setCurrentUser())
+
+		lu = strings.ToLower(ac.params.user)
+	} else if lu != strings.ToLower(optionNone) && lu != "x" && lu != jira.AssigneeDefault {
 		cmdutil.ExitIfError(ac.setAvailableUsers(project))
 		cmdutil.ExitIfError(ac.setAssignee(project))
 
@@ -79,10 +92,7 @@ func assign(cmd *cobra.Command, args []string) {
 	}
 
 	u, err := ac.verifyAssignee()
-	if err != nil {
-		cmdutil.Failed("Error: %s", err.Error())
-		return
-	}
+	cmdutil.ExitIfError(err)
 
 	var assignee, uname string
 
@@ -97,6 +107,11 @@ func assign(cmd *cobra.Command, args []string) {
 		uname = assignee
 	}
 
+	if len(ac.params.keys) > 1 {
+		bulkAssign(client, ac.params.keys, u, assignee, uname)
+		return
+	}
+
 	err = func() error {
 		var s *spinner.Spinner
 		if uname == "unassigned" {
@@ -118,29 +133,69 @@ func assign(cmd *cobra.Command, args []string) {
 	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), ac.params.key))
 }
 
+// bulkAssign assigns multiple issues to the user and reports a summary.
+// It continues on individual failures and exits with failure if any issue failed.
+func bulkAssign(client *jira.Client, keys []string, u *jira.User, assignee, uname string) {
+	results := func() []jira.BulkResult {
+		s := cmdutil.NewProgress(fmt.Sprintf("Updating assignee of %d issues...", len(keys)), len(keys))
+		defer s.Stop()
+
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
+
+		ctx = jira.WithProgress(ctx, s.Update)
+
+		return api.ProxyBulkAssign(ctx, client, keys, u, assignee)
+	}()
+
+	passed, err := cmdutil.BulkError(results)
+	if passed > 0 {
+		if uname == "unassigned" {
+			cmdutil.Success("User unassigned from %d of %d issues", passed, len(results))
+		} else {
+			cmdutil.Success("User %q assigned to %d of %d issues", uname, passed, len(results))
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
+
 type assignParams struct {
 	key   string
+	keys  []string
 	user  string
+	me    bool
 	debug bool
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *assignParams {
-	var key, user string
+	var (
+		key, user string
+		keys      []string
+	)
 
 	nargs := len(args)
 	if nargs >= 1 {
-		key = cmdutil.GetJiraIssueKey(project, args[0])
+		keys = cmdutil.GetJiraIssueKeys(project, args[0])
+		cmdutil.ExitIfError(cmdutil.ValidateIssueKeys(keys...))
+		if len(keys) > 0 {
+			key = keys[0]
+		}
 	}
 	if nargs >= 2 {
 		user = args[1]
 	}
 
+	me, err := flags.GetBool("me")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &assignParams{
 		key:   key,
+		keys:  keys,
 		user:  user,
+		me:    me,
 		debug: debug,
 	}
 }
@@ -236,6 +291,27 @@ func (ac *assignCmd) setAssignee(project string) error {
 	return nil
 }
 
+func (ac *assignCmd) setCurrentUser() error {
+	s := cmdutil.Info("Fetching current user details...")
+	defer s.Stop()
+
+	me, err := ac.client.Me()
+	if err != nil {
+		return err
+	}
+
+	ac.users = []*jira.User{{
+		AccountID:   me.AccountID,
+		Email:       me.Email,
+		Name:        me.Login,
+		DisplayName: me.Name,
+		Active:      true,
+	}}
+	ac.params.user = getQueryableName(me.Login, me.Name)
+
+	return nil
+}
+
 func (ac *assignCmd) getOptions(last bool) []string {
 	var validUsers []string
 
@@ -330,10 +406,10 @@ func (ac *assignCmd) verifyAssignee() (*jira.User, error) {
 	}
 
 	if user == nil {
-		return nil, fmt.Errorf("invalid assignee %q", ac.params.user)
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("invalid assignee %q", ac.params.user)}
 	}
 	if !user.Active {
-		return nil, fmt.Errorf("user %q is not active", getQueryableName(user.Name, user.DisplayName))
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("user %q is not active", getQueryableName(user.Name, user.DisplayName))}
 	}
 	return user, nil
 }
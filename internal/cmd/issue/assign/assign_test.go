@@ -0,0 +1,63 @@
+package assign
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestVerifyAssignee(t *testing.T) {
+	t.Parallel()
+
+	users := []*jira.User{
+		{Name: "alice", DisplayName: "Alice", Email: "alice@example.com", Active: true},
+		{Name: "bob", DisplayName: "Bob", Active: false},
+	}
+
+	cases := []struct {
+		name     string
+		user     string
+		expected *jira.User
+		err      string
+	}{
+		{
+			name:     "it finds the user by email",
+			user:     "ALICE@example.com",
+			expected: users[0],
+		},
+		{
+			name: "it skips lookup to unassign",
+			user: "x",
+		},
+		{
+			name: "it fails with a validation error for an unknown user",
+			user: "carol",
+			err:  `invalid assignee "carol"`,
+		},
+		{
+			name: "it fails with a validation error for an inactive user",
+			user: "bob",
+			err:  `user "bob" is not active`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ac := assignCmd{users: users, params: &assignParams{user: tc.user}}
+
+			u, err := ac.verifyAssignee()
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.Equal(t, cmdutil.ExitValidation, cmdutil.ExitCode(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, u)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
@@ -36,8 +37,9 @@ func NewCmdClone() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": "ISSUE-KEY\tKey of the issue to clone, eg: ISSUE-1",
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  clone,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               clone,
 	}
 
 	setFlags(&cmd)
@@ -1,7 +1,9 @@
 package add
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -31,9 +33,32 @@ $ jira issue comment add ISSUE-1 --template /path/to/template.tmpl
 # Get comment body from standard input
 $ jira issue comment add ISSUE-1 --template -
 
+# Or, read the comment body from a file or standard input using the body flag
+$ jira issue comment add ISSUE-1 --body @comment.md
+$ cat comment.md | jira issue comment add ISSUE-1 --body -
+
+# Write the comment in $EDITOR, aborts if nothing is saved
+$ jira issue comment add ISSUE-1 --editor
+
 # Or, use pipe to read input directly from standard input
 $ echo "Comment from stdin" | jira issue comment add ISSUE-1
 
+# Add the same comment to multiple issues
+$ jira issue comment add ISSUE-1,ISSUE-2 --body "heads up"
+
+# Mention users inline using @name, mentioned users are notified
+$ jira issue comment add ISSUE-1 "@alice can you take a look?"
+
+# Or, mention users explicitly instead of parsing the comment
+$ jira issue comment add ISSUE-1 "Can you take a look?" --mention alice,bob
+
+# Post the comment in Atlassian document format (cloud installations only)
+$ jira issue comment add ISSUE-1 "My comment" --adf
+
+# Restrict the comment to members of a project role or a group
+$ jira issue comment add ISSUE-1 "For developers only" --visibility-role Developers
+$ jira issue comment add ISSUE-1 "For admins only" --visibility-group jira-administrators
+
 # Positional argument takes precedence over the template flag
 # The example below will add "comment from arg" as a comment
 $ jira issue comment add ISSUE-1 "comment from arg" --template /path/to/template.tmpl`
@@ -47,16 +72,24 @@ func NewCmdCommentAdd() *cobra.Command {
 		Long:    helpText,
 		Example: examples,
 		Annotations: map[string]string{
-			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
+			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1, or a comma separated list of keys\n" +
 				"COMMENT_BODY\tBody of the comment you want to add",
 		},
-		Run: add,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               add,
 	}
 
+	cmd.Flags().StringP("body", "b", "", "Body of the comment, same as the positional COMMENT_BODY argument.\n"+
+		"Use - to read it from stdin or @path to read it from a file")
 	cmd.Flags().Bool("web", false, "Open issue in web browser after adding comment")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
+	cmd.Flags().Bool("editor", false, "Write comment in $EDITOR, template is used as the initial content")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 	cmd.Flags().Bool("internal", false, "Make comment internal")
+	cmd.Flags().String("visibility-role", "", "Restrict comment to members of the project role")
+	cmd.Flags().String("visibility-group", "", "Restrict comment to members of the group")
+	cmd.Flags().Bool("adf", false, "Post comment in Atlassian document format using v3 API, ignored for local installations")
+	cmd.Flags().String("mention", "", "Users to mention in the comment, comma separated; disables parsing @name mentions in the body")
 
 	return &cmd
 }
@@ -99,18 +132,57 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
+	installation := viper.GetString("installation")
+
+	opts := jira.CommentOptions{
+		Internal:     ac.params.internal,
+		Installation: installation,
+		ADF:          ac.params.adf && installation != jira.InstallationTypeLocal,
+		Visibility:   ac.params.visibility,
+	}
+	opts.Mentions, ac.params.body = ac.resolveMentions()
+
+	if len(ac.params.issueKeys) > 1 {
+		results := func() []jira.BulkResult {
+			s := cmdutil.NewProgress(fmt.Sprintf("Adding comment to %d issues", len(ac.params.issueKeys)), len(ac.params.issueKeys))
+			defer s.Stop()
+
+			ctx, cancel := cmdutil.InterruptContext()
+			defer cancel()
+
+			ctx = jira.WithProgress(ctx, s.Update)
+
+			return client.BulkComment(ctx, ac.params.issueKeys, ac.params.body, &opts)
+		}()
+
+		passed, err := cmdutil.BulkError(results)
+		if passed > 0 {
+			cmdutil.Success("Comment added to %d of %d issues", passed, len(results))
+		}
+		cmdutil.ExitIfError(err)
+		return
+	}
+
+	comment, err := func() (*jira.Comment, error) {
 		s := cmdutil.Info("Adding comment")
 		defer s.Stop()
 
-		return client.AddIssueComment(ac.params.issueKey, ac.params.body, ac.params.internal)
+		return client.CreateIssueComment(context.Background(), ac.params.issueKey, ac.params.body, &opts)
 	}()
 	cmdutil.ExitIfError(err)
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Comment added to issue %q", ac.params.issueKey)
-	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
+	if comment.ID != "" {
+		cmdutil.Success("Comment %s added to issue %q", comment.ID, ac.params.issueKey)
+		fmt.Printf(
+			"%s?focusedCommentId=%s\n",
+			cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey), comment.ID,
+		)
+	} else {
+		cmdutil.Success("Comment added to issue %q", ac.params.issueKey)
+		fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, ac.params.issueKey)
@@ -119,44 +191,102 @@ func add(cmd *cobra.Command, args []string) {
 }
 
 type addParams struct {
-	issueKey string
-	body     string
-	template string
-	noInput  bool
-	internal bool
-	debug    bool
+	issueKey   string
+	issueKeys  []string
+	body       string
+	template   string
+	editor     bool
+	noInput    bool
+	internal   bool
+	mentions   []string
+	adf        bool
+	visibility *jira.CommentVisibility
+	debug      bool
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
-	var issueKey, body string
+	var (
+		issueKey, body string
+		issueKeys      []string
+	)
 
 	nargs := len(args)
 	if nargs >= 1 {
-		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+		issueKeys = cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+		cmdutil.ExitIfError(cmdutil.ValidateIssueKeys(issueKeys...))
+		if len(issueKeys) > 0 {
+			issueKey = issueKeys[0]
+		}
 	}
 	if nargs >= 2 {
 		body = args[1]
 	}
 
+	if body == "" {
+		b, err := flags.GetString("body")
+		cmdutil.ExitIfError(err)
+
+		body, err = cmdutil.ReadBodyInput(b)
+		cmdutil.ExitIfError(err)
+	}
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	editor, err := flags.GetBool("editor")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
 	internal, err := flags.GetBool("internal")
 	cmdutil.ExitIfError(err)
 
+	mention, err := flags.GetString("mention")
+	cmdutil.ExitIfError(err)
+
+	var mentions []string
+	for _, m := range strings.Split(mention, ",") {
+		if m = strings.TrimPrefix(strings.TrimSpace(m), "@"); m != "" {
+			mentions = append(mentions, m)
+		}
+	}
+
+	adf, err := flags.GetBool("adf")
+	cmdutil.ExitIfError(err)
+
+	role, err := flags.GetString("visibility-role")
+	cmdutil.ExitIfError(err)
+
+	group, err := flags.GetString("visibility-group")
+	cmdutil.ExitIfError(err)
+
+	var visibility *jira.CommentVisibility
+
+	switch {
+	case role != "" && group != "":
+		cmdutil.Failed("Only one of --visibility-role and --visibility-group can be used")
+	case role != "":
+		visibility = &jira.CommentVisibility{Type: jira.CommentVisibilityRole, Value: role}
+	case group != "":
+		visibility = &jira.CommentVisibility{Type: jira.CommentVisibilityGroup, Value: group}
+	}
+
 	return &addParams{
-		issueKey: issueKey,
-		body:     body,
-		template: template,
-		noInput:  noInput,
-		internal: internal,
-		debug:    debug,
+		issueKey:   issueKey,
+		issueKeys:  issueKeys,
+		body:       body,
+		template:   template,
+		editor:     editor,
+		noInput:    noInput,
+		internal:   internal,
+		mentions:   mentions,
+		adf:        adf,
+		visibility: visibility,
+		debug:      debug,
 	}
 }
 
@@ -200,6 +330,13 @@ func (ac *addCmd) getQuestions() []*survey.Question {
 		defaultBody = string(b)
 	}
 
+	if ac.params.editor && ac.params.body == "" {
+		body, err := surveyext.Edit(defaultBody)
+		cmdutil.ExitIfError(err)
+
+		ac.params.body = body
+	}
+
 	if ac.params.noInput && ac.params.body == "" {
 		ac.params.body = defaultBody
 		return qs
@@ -244,3 +381,64 @@ func (ac *addCmd) isNonInteractive() bool {
 func (ac *addCmd) isMandatoryParamsMissing() bool {
 	return ac.params.issueKey == ""
 }
+
+// resolveMentions finds users mentioned in the comment. Users are either mentioned
+// explicitly with the --mention flag, in which case they are prepended to the body,
+// or inline using @name. Mentions that don't match any user are sent as plain text.
+func (ac *addCmd) resolveMentions() (map[string]*jira.User, string) {
+	body := ac.params.body
+	names := ac.params.mentions
+
+	if len(names) > 0 {
+		tags := make([]string, 0, len(names))
+		for _, n := range names {
+			tags = append(tags, "@"+n)
+		}
+		body = strings.Join(tags, " ") + " " + body
+	} else {
+		names = jira.ParseMentions(body)
+	}
+	if len(names) == 0 {
+		return nil, body
+	}
+
+	users, unresolved := func() (map[string]*jira.User, []string) {
+		s := cmdutil.Info("Resolving mentioned users...")
+		defer s.Stop()
+
+		var unresolved []string
+
+		users := make(map[string]*jira.User, len(names))
+		for _, n := range names {
+			u, err := findUser(ac.client, n)
+			if err != nil {
+				unresolved = append(unresolved, n)
+				continue
+			}
+			users[n] = u
+		}
+		return users, unresolved
+	}()
+
+	for _, n := range unresolved {
+		cmdutil.Warn("Unable to resolve user %q, it will be mentioned as plain text", n)
+	}
+	return users, body
+}
+
+// findUser finds the user whose account id, username, display name or email matches
+// the name exactly. Partial matches are ignored so that the wrong user is never mentioned.
+func findUser(client *jira.Client, name string) (*jira.User, error) {
+	users, err := api.ProxySearchUsers(client, name, 10)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if u.AccountID == name || strings.EqualFold(u.Name, name) ||
+			strings.EqualFold(u.DisplayName, name) || strings.EqualFold(u.Email, name) ||
+			(u.Email != "" && strings.EqualFold(strings.Split(u.Email, "@")[0], name)) {
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("user %q not found", name)
+}
@@ -1,8 +1,11 @@
 package create
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -37,6 +40,23 @@ $ jira issue create --template /path/to/template.tmpl
 # Get description from standard input
 $ jira issue create --template -
 
+# Or, read the description from a file using the body flag
+$ jira issue create -s"Summary" -tTask --body @desc.md
+
+# Write the description in $EDITOR starting from a template, aborts if nothing is saved
+$ jira issue create -s"Summary" -tTask --template /path/to/template.tmpl --editor
+
+# Create issue from a YAML or JSON file that defines all fields, including custom fields
+# Placeholders like ${PAGE} are replaced with --var values or environment variables
+$ jira issue create --from .jira/bug-report.yaml --var PAGE=login
+
+# Create many issues at once, one per row of a CSV file with a header row like:
+# type,summary,priority,labels,custom.story-points
+$ jira issue create --from backlog.csv
+
+# Read the CSV from stdin
+$ cat backlog.csv | jira issue create --from - --csv
+
 # Create issue in the configured project with JSON output
 $ jira issue create --raw
 
@@ -47,7 +67,10 @@ $ echo "Description from stdin" | jira issue create -s"Summary" -tTask
 # The example below will add "Body from flag" as an issue description
 $ jira issue create -tTask -sSummary -b"Body from flag" --template /path/to/template.tpl`
 
-	flagRaw = "raw"
+	flagRaw  = "raw"
+	flagFrom = "from"
+	flagCSV  = "csv"
+	flagVar  = "var"
 )
 
 // NewCmdCreate is a create command.
@@ -61,6 +84,10 @@ func NewCmdCreate() *cobra.Command {
 	}
 
 	cmd.Flags().Bool(flagRaw, false, "Print output in JSON format")
+	cmd.Flags().Bool("copy", false, "Copy URL of the created issue to the clipboard")
+	cmd.Flags().String(flagFrom, "", "Path to a YAML or JSON file defining the issue fields, or a CSV file to create an issue per row")
+	cmd.Flags().Bool(flagCSV, false, "Parse the --from input as CSV regardless of the file extension, eg: when reading it from stdin")
+	cmd.Flags().StringToString(flagVar, map[string]string{}, "Values for ${VAR} placeholders in the --from file, environment variables are used otherwise")
 
 	return &cmd
 }
@@ -73,10 +100,40 @@ func SetFlags(cmd *cobra.Command) {
 func create(cmd *cobra.Command, _ []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
-	installation := viper.GetString("installation")
 
 	params := parseFlags(cmd.Flags())
+	if from, _ := cmd.Flags().GetString(flagFrom); from != "" {
+		vars, err := cmd.Flags().GetStringToString(flagVar)
+		cmdutil.ExitIfError(err)
+
+		isCSV, err := cmd.Flags().GetBool(flagCSV)
+		cmdutil.ExitIfError(err)
+
+		if isCSV || strings.EqualFold(filepath.Ext(from), ".csv") {
+			tpls, err := cmdcommon.ReadIssueTemplatesCSV(from, vars)
+			cmdutil.ExitIfError(err)
+
+			if cmd.Flags().Changed("project") {
+				for _, tpl := range tpls {
+					tpl.Project = project
+				}
+			}
+			createBulk(cmd, params, tpls)
+			return
+		}
+
+		tpl, err := cmdcommon.ReadIssueTemplate(from, vars)
+		cmdutil.ExitIfError(err)
+
+		if tpl.Project != "" && !cmd.Flags().Changed("project") {
+			project = tpl.Project
+			viper.Set("project.key", project)
+		}
+		tpl.Apply(params)
+
+		// Issues created from a file are meant to be scripted, so don't prompt for anything.
+		params.NoInput = true
+	}
 	client := api.DefaultClient(params.Debug)
 	cc := createCmd{
 		client: client,
@@ -94,6 +151,7 @@ func create(cmd *cobra.Command, _ []string) {
 	}
 
 	cmdutil.ExitIfError(cc.setIssueTypes())
+	cmdutil.ExitIfError(cc.validateIssueType(project))
 	cmdutil.ExitIfError(cc.askQuestions())
 
 	if !params.NoInput {
@@ -101,42 +159,21 @@ func create(cmd *cobra.Command, _ []string) {
 		cmdutil.ExitIfError(err)
 	}
 
+	if params.OriginalEstimate != "" {
+		estimate, err := jira.ParseJiraDuration(params.OriginalEstimate)
+		cmdutil.ExitIfError(err)
+		params.OriginalEstimate = estimate
+	}
+
 	params.Reporter = cmdcommon.GetRelevantUser(client, project, params.Reporter)
 	params.Assignee = cmdcommon.GetRelevantUser(client, project, params.Assignee)
+	params.SecurityLevel = cmdcommon.GetSecurityLevelID(client, project, params.SecurityLevel)
 
 	issue, err := func() (*jira.CreateResponse, error) {
 		s := cmdutil.Info("Creating an issue...")
 		defer s.Stop()
 
-		cr := jira.CreateRequest{
-			Project:          project,
-			IssueType:        params.IssueType,
-			ParentIssueKey:   params.ParentIssueKey,
-			Summary:          params.Summary,
-			Body:             params.Body,
-			Reporter:         params.Reporter,
-			Assignee:         params.Assignee,
-			Priority:         params.Priority,
-			Labels:           params.Labels,
-			Components:       params.Components,
-			FixVersions:      params.FixVersions,
-			AffectsVersions:  params.AffectsVersions,
-			OriginalEstimate: params.OriginalEstimate,
-			CustomFields:     params.CustomFields,
-			EpicField:        viper.GetString("epic.link"),
-		}
-		cr.ForProjectType(projectType)
-		cr.ForInstallationType(installation)
-		if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
-			cmdcommon.ValidateCustomFields(cr.CustomFields, configuredCustomFields)
-			cr.WithCustomFields(configuredCustomFields)
-		}
-
-		if handle := cmdutil.GetSubtaskHandle(params.IssueType, cc.issueTypes); handle != "" {
-			cr.SubtaskField = handle
-		}
-
-		return client.CreateV2(&cr)
+		return client.CreateV2(newCreateRequest(project, params, cc.issueTypes))
 	}()
 
 	cmdutil.ExitIfError(err)
@@ -152,12 +189,142 @@ func create(cmd *cobra.Command, _ []string) {
 
 	cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, issue.Key))
 
+	if cp, _ := cmd.Flags().GetBool("copy"); cp {
+		cmdutil.CopyToClipboard(cmdutil.GenerateServerBrowseURL(server, issue.Key))
+	}
+
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, issue.Key)
 		cmdutil.ExitIfError(err)
 	}
 }
 
+func newCreateRequest(project string, params *cmdcommon.CreateParams, issueTypes []*jira.IssueType) *jira.CreateRequest {
+	cr := jira.CreateRequest{
+		Project:          project,
+		IssueType:        params.IssueType,
+		ParentIssueKey:   params.ParentIssueKey,
+		Summary:          params.Summary,
+		Body:             params.Body,
+		Reporter:         params.Reporter,
+		Assignee:         params.Assignee,
+		Priority:         params.Priority,
+		Labels:           params.Labels,
+		Components:       params.Components,
+		FixVersions:      params.FixVersions,
+		AffectsVersions:  params.AffectsVersions,
+		OriginalEstimate: params.OriginalEstimate,
+		SecurityLevel:    params.SecurityLevel,
+		CustomFields:     params.CustomFields,
+		EpicField:        viper.GetString("epic.link"),
+	}
+	cr.ForProjectType(viper.GetString("project.type"))
+	cr.ForInstallationType(viper.GetString("installation"))
+	if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
+		cmdcommon.ValidateCustomFields(cr.CustomFields, configuredCustomFields)
+		cr.WithCustomFields(configuredCustomFields)
+	}
+
+	if handle := cmdutil.GetSubtaskHandle(params.IssueType, issueTypes); handle != "" {
+		cr.SubtaskField = handle
+	}
+
+	return &cr
+}
+
+// createBulk creates an issue for each row of a CSV file. Flags apply to every row and take
+// precedence over the values in the file. All rows are validated before creating anything,
+// after that failures are reported per row.
+func createBulk(cmd *cobra.Command, params *cmdcommon.CreateParams, tpls []*cmdcommon.IssueTemplate) {
+	client := api.DefaultClient(params.Debug)
+	cc := createCmd{client: client, params: params}
+	cmdutil.ExitIfError(cc.setIssueTypes())
+
+	var (
+		reqs  = make([]*jira.CreateRequest, 0, len(tpls))
+		users = make(map[string]string)
+	)
+
+	relevantUser := func(project, user string) string {
+		if id, ok := users[project+"/"+user]; ok {
+			return id
+		}
+		id := cmdcommon.GetRelevantUser(client, project, user)
+		users[project+"/"+user] = id
+		return id
+	}
+
+	for i, tpl := range tpls {
+		p := *params
+		tpl.Apply(&p)
+
+		// Rows are numbered as shown in a spreadsheet, the header being row 1.
+		row := i + 2
+
+		if p.Summary == "" || p.IssueType == "" {
+			cmdutil.ExitIfError(&cmdutil.ValidationError{
+				Msg: fmt.Sprintf("row %d: summary and type are mandatory", row),
+			})
+		}
+		if p.OriginalEstimate != "" {
+			estimate, err := jira.ParseJiraDuration(p.OriginalEstimate)
+			if err != nil {
+				cmdutil.ExitIfError(&cmdutil.ValidationError{Msg: fmt.Sprintf("row %d: %s", row, err)})
+			}
+			p.OriginalEstimate = estimate
+		}
+
+		project := cmp.Or(tpl.Project, viper.GetString("project.key"))
+		if p.ParentIssueKey != "" {
+			p.ParentIssueKey = cmdutil.GetJiraIssueKey(project, p.ParentIssueKey)
+		}
+		p.Reporter = relevantUser(project, p.Reporter)
+		p.Assignee = relevantUser(project, p.Assignee)
+		p.SecurityLevel = cmdcommon.GetSecurityLevelID(client, project, p.SecurityLevel)
+
+		reqs = append(reqs, newCreateRequest(project, &p, cc.issueTypes))
+	}
+
+	created, err := func() ([]jira.BulkCreateResult, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating %d issues...", len(reqs)))
+		defer s.Stop()
+
+		return client.CreateBulkV2(reqs)
+	}()
+	cmdutil.ExitIfError(err)
+
+	jsonFlag, err := cmd.Flags().GetBool(flagRaw)
+	cmdutil.ExitIfError(err)
+
+	results := make([]jira.BulkResult, 0, len(created))
+	rows := make([]map[string]interface{}, 0, len(created))
+	for i, c := range created {
+		row := map[string]interface{}{"row": i + 2, "key": c.Key}
+		if c.Err != nil {
+			row["error"] = cmdutil.NormalizeJiraError(c.Err.Error())
+			results = append(results, jira.BulkResult{Key: fmt.Sprintf("row %d", i+2), Err: c.Err})
+		} else {
+			results = append(results, jira.BulkResult{Key: c.Key})
+			if !jsonFlag {
+				fmt.Printf("row %d\t%s\n", i+2, c.Key)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if jsonFlag {
+		jsonData, err := json.Marshal(rows)
+		cmdutil.ExitIfError(err)
+		fmt.Println(string(jsonData))
+	}
+
+	passed, err := cmdutil.BulkError(results)
+	if passed > 0 && !jsonFlag {
+		cmdutil.Success("Created %d of %d issues", passed, len(results))
+	}
+	cmdutil.ExitIfError(err)
+}
+
 type createCmd struct {
 	client     *jira.Client
 	issueTypes []*jira.IssueType
@@ -189,6 +356,47 @@ func (cc *createCmd) setIssueTypes() error {
 	return nil
 }
 
+// validateIssueType makes sure the issue type passed with the `--type` flag exists in the project.
+// Issue types in the config may be stale or belong to a different project, so the project
+// metadata is consulted before failing.
+func (cc *createCmd) validateIssueType(project string) error {
+	if cc.params.IssueType == "" || hasIssueType(cc.issueTypes, cc.params.IssueType) {
+		return nil
+	}
+
+	issueTypes, err := func() ([]*jira.IssueType, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching issue types of project %s...", project))
+		defer s.Stop()
+
+		return cc.client.GetIssueTypes(project)
+	}()
+	if err != nil {
+		return err
+	}
+	if !hasIssueType(issueTypes, cc.params.IssueType) {
+		names := make([]string, 0, len(issueTypes))
+		for _, t := range issueTypes {
+			names = append(names, t.Name)
+		}
+		return fmt.Errorf(
+			"issue type %q doesn't exist in project %s, valid types are: %s",
+			cc.params.IssueType, project, strings.Join(names, ", "),
+		)
+	}
+	cc.issueTypes = issueTypes
+
+	return nil
+}
+
+func hasIssueType(issueTypes []*jira.IssueType, name string) bool {
+	for _, t := range issueTypes {
+		if strings.EqualFold(t.Name, name) || (t.Handle != "" && strings.EqualFold(t.Handle, name)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (cc *createCmd) getIssueType() *survey.Question {
 	var qs *survey.Question
 
@@ -297,6 +505,13 @@ func (cc *createCmd) getRemainingQuestions() []*survey.Question {
 		defaultBody = string(b)
 	}
 
+	if cc.params.Editor && cc.params.Body == "" {
+		body, err := surveyext.Edit(defaultBody)
+		cmdutil.ExitIfError(err)
+
+		cc.params.Body = body
+	}
+
 	if cc.params.NoInput {
 		if cc.params.Body == "" {
 			cc.params.Body = defaultBody
@@ -343,6 +558,9 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 	body, err := flags.GetString("body")
 	cmdutil.ExitIfError(err)
 
+	body, err = cmdutil.ReadBodyInput(body)
+	cmdutil.ExitIfError(err)
+
 	priority, err := flags.GetString("priority")
 	cmdutil.ExitIfError(err)
 
@@ -367,12 +585,18 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 	originalEstimate, err := flags.GetString("original-estimate")
 	cmdutil.ExitIfError(err)
 
+	securityLevel, err := flags.GetString("security")
+	cmdutil.ExitIfError(err)
+
 	custom, err := flags.GetStringToString("custom")
 	cmdutil.ExitIfError(err)
 
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	editor, err := flags.GetBool("editor")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -392,8 +616,10 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 		FixVersions:      fixVersions,
 		AffectsVersions:  affectsVersions,
 		OriginalEstimate: originalEstimate,
+		SecurityLevel:    securityLevel,
 		CustomFields:     custom,
 		Template:         template,
+		Editor:           editor,
 		NoInput:          noInput,
 		Debug:            debug,
 	}
@@ -1,6 +1,7 @@
 package delete
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -8,17 +9,23 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Delete deletes an issue. To delete a task with subtasks, use '--cascade' flag.`
+	helpText = `Delete deletes an issue. To delete a task with subtasks, use '--cascade' flag.
+
+You will be asked to confirm the deletion unless the '--force' flag is passed.`
 	examples = `$ jira issue delete ISSUE-1
 
 # Delete task along with all of its subtasks
-$ jira issue delete ISSUE-1 --cascade`
+$ jira issue delete ISSUE-1 --cascade
+
+# Delete without confirmation
+$ jira issue delete ISSUE-1 --force`
 )
 
 // NewCmdDelete is a delete command.
@@ -32,10 +39,12 @@ func NewCmdDelete() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
 		},
-		Run: del,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               del,
 	}
 
 	cmd.Flags().Bool("cascade", false, "Delete issue along with its subtasks")
+	cmd.Flags().Bool("force", false, "Delete issue without confirmation")
 
 	return &cmd
 }
@@ -52,12 +61,19 @@ func del(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(mc.setIssueKey(project))
 
+	if !mc.params.force && !mc.confirm() {
+		cmdutil.Failed("Action aborted, use '--force' to delete without confirmation")
+	}
+
 	err := func() error {
 		s := cmdutil.Info(fmt.Sprintf("Removing issue %q", mc.params.key))
 		defer s.Stop()
 
 		return client.DeleteIssue(mc.params.key, mc.params.cascade)
 	}()
+	if errors.Is(err, jira.ErrIssueHasSubtasks) {
+		cmdutil.Failed("Issue %q has subtasks, use '--cascade' to delete the issue along with its subtasks", mc.params.key)
+	}
 	cmdutil.ExitIfError(err)
 
 	cmdutil.Success(fmt.Sprintf("Issue %q removed successfully", mc.params.key))
@@ -66,6 +82,7 @@ func del(cmd *cobra.Command, args []string) {
 type deleteParams struct {
 	key     string
 	cascade bool
+	force   bool
 	debug   bool
 }
 
@@ -80,12 +97,16 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *d
 	cascade, err := flags.GetBool("cascade")
 	cmdutil.ExitIfError(err)
 
+	force, err := flags.GetBool("force")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &deleteParams{
 		key:     key,
 		cascade: cascade,
+		force:   force,
 		debug:   debug,
 	}
 }
@@ -115,3 +136,19 @@ func (mc *deleteCmd) setIssueKey(project string) error {
 
 	return nil
 }
+
+func (mc *deleteCmd) confirm() bool {
+	var ans bool
+
+	msg := fmt.Sprintf("Are you sure you want to delete issue %q?", mc.params.key)
+	if mc.params.cascade {
+		msg = fmt.Sprintf("Are you sure you want to delete issue %q along with its subtasks?", mc.params.key)
+	}
+
+	prompt := &survey.Confirm{Message: msg}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		return false
+	}
+
+	return ans
+}
@@ -1,7 +1,9 @@
 package edit
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -28,11 +30,25 @@ $ jira issue edit ISSUE-1 -s"New Bug" -yHigh -lbug -lurgent -CBackend -b"Bug des
 # Use --no-input option to disable interactive prompt
 $ jira issue edit ISSUE-1 -s"New updated summary" --no-input
 
+# Read the description body from a file, or edit the current description in $EDITOR
+$ jira issue edit ISSUE-1 --body @desc.md --no-input
+$ cat desc.md | jira issue edit ISSUE-1 --body - --no-input
+$ jira issue edit ISSUE-1 --editor --no-input
+
 # Use pipe to read the description body directly from standard input
 $ echo "Description from stdin" | jira issue edit ISSUE-1 -s"New updated summary"  --no-input
 
 # Use minus (-) to remove label, component or fixVersion
-$ jira issue edit ISSUE-1 --label -urgent --component -BE --fix-version -v1.0`
+$ jira issue edit ISSUE-1 --label -urgent --component -BE --fix-version -v1.0
+
+# Labels not used anywhere in the instance yet result in a warning, use --force to skip the check
+$ jira issue edit ISSUE-1 --label brand-new-label --force
+
+# Set the original estimate without logging any work
+$ jira issue edit ISSUE-1 --original-estimate 3d --no-input
+
+# Move the issue under an epic, use "x" to remove the parent
+$ jira issue edit ISSUE-1 --parent EPIC-1 --no-input`
 )
 
 // NewCmdEdit is an edit command.
@@ -46,8 +62,9 @@ func NewCmdEdit() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  edit,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               edit,
 	}
 
 	setFlags(&cmd)
@@ -60,7 +77,9 @@ func edit(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
-	client := api.DefaultClient(params.debug)
+	// The parent is updated with a separate request, so notifications are
+	// disabled on the client for --skip-notify to apply to it as well.
+	client := api.Client(jira.Config{Debug: params.debug, SkipNotify: params.skipNotify})
 	ec := editCmd{
 		client: client,
 		params: params,
@@ -95,6 +114,10 @@ func edit(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(ec.askQuestions(issue, originalBody))
 
+	if params.editor {
+		cmdutil.ExitIfError(ec.editBody(originalBody))
+	}
+
 	if !params.noInput {
 		getAnswers(params, issue)
 	}
@@ -112,6 +135,10 @@ func edit(cmd *cobra.Command, args []string) {
 		params.body = ""
 	}
 
+	if !params.force && viper.GetString("installation") != jira.InstallationTypeLocal {
+		warnNewLabels(client, params.labels, issue.Fields.Labels)
+	}
+
 	labels := params.labels
 	labels = append(labels, issue.Fields.Labels...)
 
@@ -121,18 +148,29 @@ func edit(cmd *cobra.Command, args []string) {
 	}
 	components = append(components, params.components...)
 
-	fixVersions := make([]string, 0, len(issue.Fields.FixVersions)+len(params.fixVersions))
-	for _, fv := range issue.Fields.FixVersions {
-		fixVersions = append(fixVersions, fv.Name)
-	}
-	fixVersions = append(fixVersions, params.fixVersions...)
-
 	affectsVersions := make([]string, 0, len(issue.Fields.AffectsVersions)+len(params.affectsVersions))
 	for _, fv := range issue.Fields.AffectsVersions {
 		affectsVersions = append(affectsVersions, fv.Name)
 	}
 	affectsVersions = append(affectsVersions, params.affectsVersions...)
 
+	fixVersions := make([]string, 0, len(params.fixVersions))
+	for _, v := range params.fixVersions {
+		if v = strings.TrimSpace(v); v != "" {
+			fixVersions = append(fixVersions, v)
+		}
+	}
+
+	// Versions are defined per project, which is the prefix of the issue key.
+	issueProject, _, _ := strings.Cut(params.issueKey, "-")
+
+	versionIDs, err := client.GetVersionIDs(issueProject, fixVersions...)
+	cmdutil.ExitIfError(err)
+
+	if params.securityLevel != "" {
+		params.securityLevel = cmdcommon.GetSecurityLevelID(client, issueProject, params.securityLevel)
+	}
+
 	err = func() error {
 		s := cmdutil.Info("Updating an issue...")
 		defer s.Stop()
@@ -142,35 +180,51 @@ func edit(cmd *cobra.Command, args []string) {
 			body = md.ToJiraMD(body)
 		}
 
-		parent := cmdutil.GetJiraIssueKey(project, params.parentIssueKey)
-		if parent == "" && issue.Fields.Parent != nil {
+		var parent string
+		if issue.Fields.Parent != nil {
 			parent = issue.Fields.Parent.Key
 		}
 
 		edr := jira.EditRequest{
-			ParentIssueKey:  parent,
-			Summary:         params.summary,
-			Body:            body,
-			Priority:        params.priority,
-			Labels:          labels,
-			Components:      components,
-			FixVersions:     fixVersions,
-			AffectsVersions: affectsVersions,
-			CustomFields:    params.customFields,
-			SkipNotify:      params.skipNotify,
+			ParentIssueKey:   parent,
+			Summary:          params.summary,
+			Body:             body,
+			Priority:         params.priority,
+			Labels:           labels,
+			Components:       components,
+			FixVersions:      fixVersions,
+			AffectsVersions:  affectsVersions,
+			SecurityLevel:    params.securityLevel,
+			OriginalEstimate: params.originalEstimate,
+			CustomFields:     params.customFields,
+			SkipNotify:       params.skipNotify,
 		}
 		if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
 			cmdcommon.ValidateCustomFields(edr.CustomFields, configuredCustomFields)
 			edr.WithCustomFields(configuredCustomFields)
 		}
+		edr.WithVersionIDs(versionIDs)
 
-		return client.Edit(params.issueKey, &edr)
+		// In dry run mode keep going so that every request of the edit is printed.
+		err := client.Edit(params.issueKey, &edr)
+		if err != nil && !errors.Is(err, jira.ErrDryRun) {
+			return err
+		}
+		if perr := updateParent(client, project, params.issueKey, params.parentIssueKey); perr != nil {
+			return perr
+		}
+		return err
 	}()
-	cmdutil.ExitIfError(err)
-
-	cmdutil.Success("Issue updated\n%s", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
+	dryRun := errors.Is(err, jira.ErrDryRun)
+	if !dryRun {
+		cmdutil.ExitIfError(err)
+		cmdutil.Success("Issue updated\n%s", cmdutil.GenerateServerBrowseURL(server, params.issueKey))
+	}
 
 	handleUserAssign(project, params.issueKey, params.assignee, client)
+	if dryRun {
+		return
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, params.issueKey)
@@ -178,6 +232,18 @@ func edit(cmd *cobra.Command, args []string) {
 	}
 }
 
+// updateParent sets or, if the parent is "x", clears the parent of the issue.
+func updateParent(client *jira.Client, project, key, parent string) error {
+	switch parent {
+	case "":
+		return nil
+	case "x":
+		return client.ClearIssueParent(key)
+	default:
+		return client.SetIssueParent(key, cmdutil.GetJiraIssueKey(project, parent))
+	}
+}
+
 func getAnswers(params *editParams, issue *jira.Issue) {
 	answer := struct{ Action string }{}
 	for answer.Action != cmdcommon.ActionSubmit {
@@ -229,7 +295,7 @@ func handleUserAssign(project, key, assignee string, client *jira.Client) {
 		return
 	}
 	if assignee == "x" {
-		if err := api.ProxyAssignIssue(client, key, nil, jira.AssigneeNone); err != nil {
+		if err := api.ProxyAssignIssue(client, key, nil, jira.AssigneeNone); err != nil && !errors.Is(err, jira.ErrDryRun) {
 			cmdutil.Failed("Unable to unassign user: %s", err.Error())
 		}
 		return
@@ -241,7 +307,7 @@ func handleUserAssign(project, key, assignee string, client *jira.Client) {
 	if err != nil || len(user) == 0 {
 		cmdutil.Failed("Unable to find assignee")
 	}
-	if err = api.ProxyAssignIssue(client, key, user[0], assignee); err != nil {
+	if err = api.ProxyAssignIssue(client, key, user[0], assignee); err != nil && !errors.Is(err, jira.ErrDryRun) {
 		cmdutil.Failed("Unable to set assignee: %s", err.Error())
 	}
 }
@@ -269,7 +335,7 @@ func (ec *editCmd) askQuestions(issue *jira.Issue, originalBody string) error {
 		})
 	}
 
-	if ec.params.body == "" {
+	if ec.params.body == "" && !ec.params.editor {
 		qs = append(qs, &survey.Question{
 			Name: "body",
 			Prompt: &surveyext.JiraEditor{
@@ -300,21 +366,35 @@ func (ec *editCmd) askQuestions(issue *jira.Issue, originalBody string) error {
 	return nil
 }
 
+// editBody opens the description in the editor regardless of the no-input flag.
+func (ec *editCmd) editBody(originalBody string) error {
+	body, err := surveyext.Edit(originalBody)
+	if err != nil {
+		return err
+	}
+	ec.params.body = body
+	return nil
+}
+
 type editParams struct {
-	issueKey        string
-	parentIssueKey  string
-	summary         string
-	body            string
-	priority        string
-	assignee        string
-	labels          []string
-	components      []string
-	fixVersions     []string
-	affectsVersions []string
-	customFields    map[string]string
-	skipNotify      bool
-	noInput         bool
-	debug           bool
+	issueKey         string
+	parentIssueKey   string
+	summary          string
+	body             string
+	editor           bool
+	priority         string
+	assignee         string
+	labels           []string
+	components       []string
+	fixVersions      []string
+	affectsVersions  []string
+	securityLevel    string
+	originalEstimate string
+	customFields     map[string]string
+	skipNotify       bool
+	force            bool
+	noInput          bool
+	debug            bool
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *editParams {
@@ -327,6 +407,16 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	body, err := flags.GetString("body")
 	cmdutil.ExitIfError(err)
 
+	body, err = cmdutil.ReadBodyInput(body)
+	cmdutil.ExitIfError(err)
+
+	editor, err := flags.GetBool("editor")
+	cmdutil.ExitIfError(err)
+
+	if editor && body != "" {
+		cmdutil.Failed("Error: --editor cannot be used along with --body")
+	}
+
 	priority, err := flags.GetString("priority")
 	cmdutil.ExitIfError(err)
 
@@ -345,12 +435,26 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	affectsVersions, err := flags.GetStringArray("affects-version")
 	cmdutil.ExitIfError(err)
 
+	securityLevel, err := flags.GetString("security")
+	cmdutil.ExitIfError(err)
+
+	originalEstimate, err := flags.GetString("original-estimate")
+	cmdutil.ExitIfError(err)
+
+	if originalEstimate != "" {
+		originalEstimate, err = jira.ParseJiraDuration(originalEstimate)
+		cmdutil.ExitIfError(err)
+	}
+
 	custom, err := flags.GetStringToString("custom")
 	cmdutil.ExitIfError(err)
 
 	skipNotify, err := flags.GetBool("skip-notify")
 	cmdutil.ExitIfError(err)
 
+	force, err := flags.GetBool("force")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -358,20 +462,24 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	cmdutil.ExitIfError(err)
 
 	return &editParams{
-		issueKey:        cmdutil.GetJiraIssueKey(project, args[0]),
-		parentIssueKey:  parentIssueKey,
-		summary:         summary,
-		body:            body,
-		priority:        priority,
-		assignee:        assignee,
-		labels:          labels,
-		components:      components,
-		fixVersions:     fixVersions,
-		affectsVersions: affectsVersions,
-		customFields:    custom,
-		skipNotify:      skipNotify,
-		noInput:         noInput,
-		debug:           debug,
+		issueKey:         cmdutil.GetJiraIssueKey(project, args[0]),
+		parentIssueKey:   parentIssueKey,
+		summary:          summary,
+		body:             body,
+		editor:           editor,
+		priority:         priority,
+		assignee:         assignee,
+		labels:           labels,
+		components:       components,
+		fixVersions:      fixVersions,
+		affectsVersions:  affectsVersions,
+		securityLevel:    securityLevel,
+		originalEstimate: originalEstimate,
+		customFields:     custom,
+		skipNotify:       skipNotify,
+		force:            force,
+		noInput:          noInput,
+		debug:            debug,
 	}
 }
 
@@ -441,17 +549,53 @@ func setFlags(cmd *cobra.Command) {
 
 	cmd.Flags().SortFlags = false
 
-	cmd.Flags().StringP("parent", "P", "", `Link to a parent key`)
+	cmd.Flags().StringP("parent", "P", "", `Set parent of the issue, eg: an epic or a parent task. Use "x" to remove the parent`)
 	cmd.Flags().StringP("summary", "s", "", "Edit summary or title")
-	cmd.Flags().StringP("body", "b", "", "Edit description")
+	cmd.Flags().StringP("body", "b", "", "Edit description, use - to read it from stdin or @path to read it from a file")
+	cmd.Flags().Bool("editor", false, "Edit description in $EDITOR")
 	cmd.Flags().StringP("priority", "y", "", "Edit priority")
 	cmd.Flags().StringP("assignee", "a", "", "Edit assignee (email or display name)")
 	cmd.Flags().StringArrayP("label", "l", []string{}, "Append labels")
 	cmd.Flags().StringArrayP("component", "C", []string{}, "Replace components")
 	cmd.Flags().StringArray("fix-version", []string{}, "Add/Append release info (fixVersions)")
 	cmd.Flags().StringArray("affects-version", []string{}, "Add/Append release info (affectsVersions)")
+	cmd.Flags().String("security", "", "Edit security level (name or id)")
+	cmd.Flags().StringP("original-estimate", "e", "", "Set original estimate without logging work, eg: 3d 4h")
 	cmd.Flags().StringToString("custom", custom, "Edit custom fields")
 	cmd.Flags().Bool("skip-notify", false, "Do not notify watchers about the issue update")
+	cmd.Flags().Bool("force", false, "Don't warn about labels that don't exist in the instance yet")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful update")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 }
+
+// warnNewLabels warns about added labels that aren't used anywhere in the instance yet.
+// Jira creates labels on first use, so a typo silently results in a new label.
+func warnNewLabels(client *jira.Client, labels []string, existing []string) {
+	var added []string
+	for _, l := range labels {
+		if !strings.HasPrefix(l, "-") && !slices.Contains(existing, l) {
+			added = append(added, l)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	available, err := client.GetAvailableLabels("")
+	if err != nil {
+		return
+	}
+
+	var unknown []string
+	for _, l := range added {
+		if !slices.Contains(available, l) {
+			unknown = append(unknown, l)
+		}
+	}
+	if len(unknown) > 0 {
+		cmdutil.Warn(
+			"New labels not used in the instance yet will be created: %s\nUse --force to skip this check",
+			strings.Join(unknown, ", "),
+		)
+	}
+}
@@ -3,6 +3,7 @@ package issue
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/archive"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/assign"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/clone"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment"
@@ -12,7 +13,11 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/move"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/rank"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/remotelinks"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/restore"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/unlink"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/unwatch"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/view"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/watch"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog"
@@ -37,7 +42,9 @@ func NewCmdIssue() *cobra.Command {
 	cmd.AddCommand(
 		lc, cc, edit.NewCmdEdit(), move.NewCmdMove(), view.NewCmdView(), assign.NewCmdAssign(),
 		link.NewCmdLink(), unlink.NewCmdUnlink(), comment.NewCmdComment(), clone.NewCmdClone(),
-		delete.NewCmdDelete(), watch.NewCmdWatch(), worklog.NewCmdWorklog(),
+		delete.NewCmdDelete(), watch.NewCmdWatch(), unwatch.NewCmdUnwatch(), worklog.NewCmdWorklog(),
+		remotelinks.NewCmdRemoteLinks(), rank.NewCmdRank(),
+		archive.NewCmdArchive(), restore.NewCmdRestore(),
 	)
 
 	list.SetFlags(lc)
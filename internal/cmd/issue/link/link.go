@@ -11,14 +11,29 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link/remote"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText     = `Link connects two issues to a given link type.`
-	examples     = `$ jira issue link ISSUE-1 ISSUE-2 Duplicate`
+	helpText = `Link connects two issues to a given link type.
+
+The link type can either be the name of the link type, eg: Blocks, or the
+relationship it describes, eg: "blocks" or "is blocked by". Issues are swapped
+automatically so that the relationship reads as INWARD_ISSUE_KEY <relationship>
+OUTWARD_ISSUE_KEY.`
+	examples = `$ jira issue link ISSUE-1 ISSUE-2 Duplicate
+
+# ISSUE-1 blocks ISSUE-2
+$ jira issue link ISSUE-1 ISSUE-2 blocks
+
+# ISSUE-1 is blocked by ISSUE-2
+$ jira issue link ISSUE-1 ISSUE-2 "is blocked by"
+
+# Record why the issues are linked
+$ jira issue link ISSUE-1 ISSUE-2 Blocks --comment "Per discussion in the planning meeting"`
 	optionCancel = "Cancel"
 )
 
@@ -35,11 +50,13 @@ func NewCmdLink() *cobra.Command {
 				"OUTWARD_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2\n" +
 				"ISSUE_LINK_TYPE\tRelationship between two issues, eg: Duplicates, Blocks etc.",
 		},
-		Run: link,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(2),
+		Run:               link,
 	}
 
 	cmd.AddCommand(remote.NewCmdRemoteLink())
 	cmd.PersistentFlags().Bool("web", false, "Open issue in web browser after successful linking")
+	cmd.Flags().String("comment", "", "Comment to add along with the link, use - to read it from stdin or @path to read it from a file")
 
 	return &cmd
 }
@@ -64,18 +81,22 @@ func link(cmd *cobra.Command, args []string) {
 		os.Exit(0)
 	}
 
-	lt, err := lc.verifyIssueLinkType()
+	lt, swap, err := jira.ResolveIssueLinkType(lc.linkTypes, lc.params.linkType)
 	if err != nil {
 		fmt.Println()
-		cmdutil.Failed("Error: %s", err.Error())
-		return
+		cmdutil.ExitIfError(&cmdutil.ValidationError{Msg: err.Error()})
+	}
+
+	inward, outward := lc.params.inwardIssueKey, lc.params.outwardIssueKey
+	if swap {
+		inward, outward = outward, inward
 	}
 
 	err = func() error {
 		s := cmdutil.Info("Linking issues")
 		defer s.Stop()
 
-		return client.LinkIssue(lc.params.inwardIssueKey, lc.params.outwardIssueKey, lt.Name)
+		return client.LinkIssueWithComment(inward, outward, lt.Name, lc.params.comment)
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -94,6 +115,7 @@ type linkParams struct {
 	inwardIssueKey  string
 	outwardIssueKey string
 	linkType        string
+	comment         string
 	debug           bool
 }
 
@@ -111,6 +133,12 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *l
 		linkType = args[2]
 	}
 
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	comment, err = cmdutil.ReadBodyInput(comment)
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
@@ -118,6 +146,7 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *l
 		inwardIssueKey:  inwardIssueKey,
 		outwardIssueKey: outwardIssueKey,
 		linkType:        linkType,
+		comment:         comment,
 		debug:           debug,
 	}
 }
@@ -209,24 +238,3 @@ func (lc *linkCmd) setDesiredLinkType() error {
 
 	return nil
 }
-
-func (lc *linkCmd) verifyIssueLinkType() (*jira.IssueLinkType, error) {
-	var lt *jira.IssueLinkType
-
-	st := strings.ToLower(lc.params.linkType)
-	all := make([]string, 0, len(lc.linkTypes))
-	for _, t := range lc.linkTypes {
-		if strings.ToLower(t.Name) == st {
-			lt = t
-		}
-		all = append(all, fmt.Sprintf("'%s'", t.Name))
-	}
-
-	if lt == nil {
-		return nil, fmt.Errorf(
-			"invalid issue link type %q\nAvailable issue link types are: %s",
-			lc.params.linkType, strings.Join(all, ", "),
-		)
-	}
-	return lt, nil
-}
@@ -8,20 +8,34 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Adds a remote web link to an issue`
-	examples = `$ jira issue link remote ISSUE-1 http://weblink.com weblink-title`
+	helpText = `Adds a remote web link to an issue.
+
+Url and title can either be passed as arguments or using --url and --title flags.
+Use other flags to add details like relationship, icon and status to the link.`
+	examples = `$ jira issue link remote ISSUE-1 http://weblink.com weblink-title
+
+# Link a GitHub pull request with an icon and relationship
+$ jira issue link remote ISSUE-1 --url https://github.com/org/repo/pull/1 --title "PR #1" \
+  --relationship "implemented by" --icon https://github.com/favicon.ico --app-name GitHub
+
+# Use a global id so that re-running the command updates the link instead of adding a duplicate
+$ jira issue link remote ISSUE-1 --url https://ci.example.com/build/42 --title "Build #42" --global-id "ci=build/42"
+
+# Mark the linked object as resolved
+$ jira issue link remote ISSUE-1 --url https://github.com/org/repo/issues/2 --title "Bug #2" --resolved`
 )
 
 // NewCmdRemoteLink is a link command.
 func NewCmdRemoteLink() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "remote ISSUE_KEY WEBLINK_URL WEBLINK_TITLE",
+		Use:     "remote ISSUE_KEY [WEBLINK_URL] [WEBLINK_TITLE]",
 		Short:   "Adds a remote web link to an issue",
 		Long:    helpText,
 		Example: examples,
@@ -31,9 +45,21 @@ func NewCmdRemoteLink() *cobra.Command {
 				"WEBLINK_URL\tUrl of the weblink\n" +
 				"WEBLINK_TITLE\tTitle of the weblink",
 		},
-		Run: remotelink,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               remotelink,
 	}
 
+	cmd.Flags().String("url", "", "Url of the weblink")
+	cmd.Flags().String("title", "", "Title of the weblink")
+	cmd.Flags().String("summary", "", "Summary of the linked object")
+	cmd.Flags().String("relationship", "", "Relationship between the issue and the linked object, eg: \"caused by\"")
+	cmd.Flags().String("icon", "", "Url of a 16x16 icon shown next to the link")
+	cmd.Flags().String("icon-title", "", "Title of the icon")
+	cmd.Flags().Bool("resolved", false, "Mark the linked object as resolved")
+	cmd.Flags().String("global-id", "", "Globally unique id of the link, links with the same id are updated")
+	cmd.Flags().String("app-type", "", "Type of the application the link points to, eg: com.github")
+	cmd.Flags().String("app-name", "", "Name of the application the link points to, eg: GitHub")
+
 	return &cmd
 }
 
@@ -54,7 +80,7 @@ func remotelink(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Creating remote web link for issue")
 		defer s.Stop()
 
-		return client.RemoteLinkIssue(lc.params.issueKey, lc.params.title, lc.params.url)
+		return client.AddRemoteLink(lc.params.issueKey, lc.params.remoteLink())
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -70,10 +96,40 @@ func remotelink(cmd *cobra.Command, args []string) {
 }
 
 type linkParams struct {
-	issueKey string
-	url      string
-	title    string
-	debug    bool
+	issueKey     string
+	url          string
+	title        string
+	summary      string
+	relationship string
+	icon         string
+	iconTitle    string
+	resolved     bool
+	globalID     string
+	appType      string
+	appName      string
+	debug        bool
+}
+
+func (lp *linkParams) remoteLink() *jira.RemoteLink {
+	link := jira.RemoteLink{
+		GlobalID:     lp.globalID,
+		Relationship: lp.relationship,
+		Object: jira.RemoteLinkObject{
+			URL:     lp.url,
+			Title:   lp.title,
+			Summary: lp.summary,
+		},
+	}
+	if lp.icon != "" {
+		link.Object.Icon = &jira.RemoteLinkIcon{URL: lp.icon, Title: lp.iconTitle}
+	}
+	if lp.resolved {
+		link.Object.Status = &jira.RemoteLinkStatus{Resolved: true}
+	}
+	if lp.appType != "" || lp.appName != "" {
+		link.Application = &jira.RemoteLinkApplication{Type: lp.appType, Name: lp.appName}
+	}
+	return &link
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *linkParams {
@@ -89,14 +145,58 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *l
 		title = args[2]
 	}
 
+	u, err := flags.GetString("url")
+	cmdutil.ExitIfError(err)
+	if u != "" {
+		url = u
+	}
+
+	t, err := flags.GetString("title")
+	cmdutil.ExitIfError(err)
+	if t != "" {
+		title = t
+	}
+
+	summary, err := flags.GetString("summary")
+	cmdutil.ExitIfError(err)
+
+	relationship, err := flags.GetString("relationship")
+	cmdutil.ExitIfError(err)
+
+	icon, err := flags.GetString("icon")
+	cmdutil.ExitIfError(err)
+
+	iconTitle, err := flags.GetString("icon-title")
+	cmdutil.ExitIfError(err)
+
+	resolved, err := flags.GetBool("resolved")
+	cmdutil.ExitIfError(err)
+
+	globalID, err := flags.GetString("global-id")
+	cmdutil.ExitIfError(err)
+
+	appType, err := flags.GetString("app-type")
+	cmdutil.ExitIfError(err)
+
+	appName, err := flags.GetString("app-name")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &linkParams{
-		issueKey: issueKey,
-		url:      url,
-		title:    title,
-		debug:    debug,
+		issueKey:     issueKey,
+		url:          url,
+		title:        title,
+		summary:      summary,
+		relationship: relationship,
+		icon:         icon,
+		iconTitle:    iconTitle,
+		resolved:     resolved,
+		globalID:     globalID,
+		appType:      appType,
+		appName:      appName,
+		debug:        debug,
 	}
 }
 
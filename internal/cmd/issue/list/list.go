@@ -2,6 +2,7 @@ package list
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -28,7 +30,10 @@ as entering a search query into the Jira UI's search box.
 
 Issues are displayed in an interactive list view by default. You can use a --plain flag
 to display output in a plain text mode. A --no-headers flag will hide the table headers
-in plain view. A --no-truncate flag will display all available fields in plain mode.`
+in plain view. A --no-truncate flag will display all available fields in plain mode.
+
+Long values are shortened with an ellipsis to fit the terminal width in plain mode.
+Use --max-col-width to limit the width of the columns or --no-truncate to show full values.`
 
 	examples = `$ jira issue list
 
@@ -38,6 +43,12 @@ $ jira issue list --paginate 20
 # Get 50 items starting from 10
 $ jira issue list --paginate 10:50
 
+# Issues created in the last week that haven't been updated for 3 days
+$ jira issue list --created ">-7d" --updated "<-3d"
+
+# Unresolved issues assigned to you, most recently updated first
+$ jira issue list --mine
+
 # Search for issues containing specific text
 $ jira issue list "Feature Request"
 
@@ -50,6 +61,9 @@ $ jira issue list --plain --columns key,assignee,status
 # List issues in a plain table view and show all fields
 $ jira issue list --plain --no-truncate
 
+# Shorten long values to at most 50 characters
+$ jira issue list --max-col-width 50
+
 # List issues in a plain table view using custom delimiter (default is "\t")
 $ jira issue list --plain --delimeter "|"
 
@@ -63,7 +77,10 @@ $ jira issue list -tEpic -sDone
 $ jira issue list -s~Open -ax
 
 # List issues from all projects
-$ jira issue list -q"project IS NOT EMPTY"`
+$ jira issue list -q"project IS NOT EMPTY"
+
+# Run a saved filter, other filters are ignored
+$ jira issue list --saved-filter 10020`
 )
 
 // NewCmdList is a list command.
@@ -98,6 +115,15 @@ func loadList(cmd *cobra.Command, args []string) {
 	err = cmd.Flags().Set("parent", cmdutil.GetJiraIssueKey(project, pk))
 	cmdutil.ExitIfError(err)
 
+	var savedFilter string
+	if cmd.Flags().Lookup("saved-filter") != nil {
+		savedFilter, err = cmd.Flags().GetString("saved-filter")
+		cmdutil.ExitIfError(err)
+	}
+	if savedFilter != "" && len(args) > 0 {
+		cmdutil.Failed("Text query can't be used with --saved-filter")
+	}
+
 	if len(args) > 0 {
 		searchQuery := fmt.Sprintf(`text ~ %q`, strings.Join(args, " "))
 
@@ -118,7 +144,20 @@ func loadList(cmd *cobra.Command, args []string) {
 			return nil, 0, err
 		}
 
-		resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
+		client := api.DefaultClient(debug)
+
+		jql := q.Get()
+		if savedFilter != "" {
+			jql, err = client.GetFilterJQL(savedFilter)
+			if errors.Is(err, jira.ErrFilterNotFound) {
+				return nil, 0, fmt.Errorf("filter %s doesn't exist or you don't have access to it", savedFilter)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+
+		resp, err := api.ProxySearchWithFields(client, jql, q.Params().From, q.Params().Limit, searchFields(cmd))
 		if err != nil {
 			return nil, 0, err
 		}
@@ -156,6 +195,9 @@ func loadList(cmd *cobra.Command, args []string) {
 	noTruncate, err := cmd.Flags().GetBool("no-truncate")
 	cmdutil.ExitIfError(err)
 
+	maxColWidth, err := cmd.Flags().GetUint("max-col-width")
+	cmdutil.ExitIfError(err)
+
 	fixedColumns, err := cmd.Flags().GetUint("fixed-columns")
 	cmdutil.ExitIfError(err)
 
@@ -184,6 +226,7 @@ func loadList(cmd *cobra.Command, args []string) {
 			CSV:          csv,
 			NoHeaders:    noHeaders,
 			NoTruncate:   noTruncate,
+			MaxColWidth:  maxColWidth,
 			FixedColumns: fixedColumns,
 			Comments:     comments,
 			Columns: func() []string {
@@ -194,12 +237,30 @@ func loadList(cmd *cobra.Command, args []string) {
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			DateFormat: viper.GetString("date_format"),
 		},
 	}
 
 	cmdutil.ExitIfError(v.Render())
 }
 
+// searchFields returns fields to fetch when only a few columns are displayed in the
+// plain or csv mode so that the whole issue isn't fetched for every row.
+func searchFields(cmd *cobra.Command) []string {
+	raw, _ := cmd.Flags().GetBool("raw")
+	plain, _ := cmd.Flags().GetBool("plain")
+	csv, _ := cmd.Flags().GetBool("csv")
+	if raw || (!plain && !csv) {
+		return nil
+	}
+
+	columns, _ := cmd.Flags().GetString("columns")
+	if columns == "" {
+		return nil
+	}
+	return view.IssueColumnFields(strings.Split(columns, ","))
+}
+
 func outputRawJSON(issues []*jira.Issue) {
 	data, err := json.MarshalIndent(issues, "", "  ")
 	if err != nil {
@@ -214,6 +275,7 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().SortFlags = false
 
 	cmd.Flags().StringP("type", "t", "", "Filter issues by type")
+	_ = cmd.RegisterFlagCompletionFunc("type", cmdcommon.IssueTypeCompletion)
 	cmd.Flags().StringP("resolution", "R", "", "Filter issues by resolution type")
 	cmd.Flags().StringArrayP("status", "s", []string{}, "Filter issues by status")
 	cmd.Flags().StringP("priority", "y", "", "Filter issues by priority")
@@ -224,13 +286,16 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("parent", "P", "", "Filter issues by parent")
 	cmd.Flags().Bool("history", false, "Issues you accessed recently")
 	cmd.Flags().BoolP("watching", "w", false, "Issues you are watching")
+	cmd.Flags().Bool("mine", false, "Unresolved issues assigned to you ordered by last update")
 	cmd.Flags().String("created", "", "Filter issues by created date\n"+
 		"Accepts: today, week, month, year, or a date in yyyy-mm-dd and yyyy/mm/dd format,\n"+
 		"or a period format using w = weeks, d = days, h = hours, m = minutes. eg: -10d\n"+
+		"Prefix a period or a date with >, >=, < or <= to compare, eg: \">-7d\" or \"<-30d\"\n"+
 		"Created filter will have precedence over created-after and created-before filter")
 	cmd.Flags().String("updated", "", "Filter issues by updated date\n"+
 		"Accepts: today, week, month, year, or a date in yyyy-mm-dd and yyyy/mm/dd format,\n"+
 		"or a period format using w = weeks, d = days, h = hours, m = minutes. eg: -10d\n"+
+		"Prefix a period or a date with >, >=, < or <= to compare, eg: \">-7d\" or \"<-30d\"\n"+
 		"Updated filter will have precedence over updated-after and updated-before filter")
 	cmd.Flags().String("created-after", "", "Filter by issues created after certain date")
 	cmd.Flags().String("updated-after", "", "Filter by issues updated after certain date")
@@ -242,15 +307,24 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
 	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
-	cmd.Flags().Bool("no-truncate", false, "Show all available columns in plain mode. Works only with --plain")
+	cmd.Flags().Bool("no-truncate", false, "Show all available columns and don't shorten long values in plain mode. Works only with --plain")
+	cmd.Flags().Uint("max-col-width", 0, "Max width of a column, longer values are shortened with an ellipsis.\n"+
+		"In plain mode the columns are also fit to the terminal width unless --no-truncate is used")
 	cmd.Flags().String("delimiter", "\t", "Custom delimeter for columns in plain mode. Works only with --plain")
 	cmd.Flags().Uint("comments", 1, "Show N comments when viewing the issue")
 	cmd.Flags().Bool("raw", false, "Print raw JSON output")
 	cmd.Flags().Bool("csv", false, "Print output in CSV format")
 
+	cmd.MarkFlagsMutuallyExclusive("mine", "assignee")
+
 	if cmd.HasParent() && cmd.Parent().Name() != "sprint" {
 		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
 			fmt.Sprintf("Accepts: %s", strings.Join(view.ValidIssueColumns(), ", ")))
 		cmd.Flags().Uint("fixed-columns", 1, "Number of fixed columns in the interactive mode")
 	}
+
+	if cmd.HasParent() && cmd.Parent().Name() == "issue" {
+		cmd.Flags().String("saved-filter", "", "Run the JQL of a saved filter with the given ID, other filters are ignored")
+		cmd.MarkFlagsMutuallyExclusive("saved-filter", "jql")
+	}
 }
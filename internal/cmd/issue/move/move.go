@@ -10,15 +10,20 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
 )
 
 const (
 	helpText = `Move transitions an issue from one state to another.`
 	examples = `$ jira issue move ISSUE-1 "In Progress"
-$ jira issue move ISSUE-1 Done`
+$ jira issue move ISSUE-1 Done
+
+# Move multiple issues at once
+$ jira issue move ISSUE-1,ISSUE-2 --to "In Progress"`
 
 	optionCancel = "Cancel"
 )
@@ -32,14 +37,16 @@ func NewCmdMove() *cobra.Command {
 		Example: examples,
 		Aliases: []string{"transition", "mv"},
 		Annotations: map[string]string{
-			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1, or a comma separated list of keys, eg: ISSUE-1,ISSUE-2
 STATE		State you want to transition the issue to`,
 		},
-		Run: move,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               move,
 	}
 
 	cmd.Flags().SortFlags = false
 
+	cmd.Flags().String("to", "", "State you want to transition the issue to")
 	cmd.Flags().String("comment", "", "Add comment to the issue")
 	cmd.Flags().StringP("assignee", "a", "", "Assign issue to a user")
 	cmd.Flags().StringP("resolution", "R", "", "Set resolution")
@@ -53,6 +60,15 @@ func move(cmd *cobra.Command, args []string) {
 	installation := viper.GetString("installation")
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
 	client := api.DefaultClient(params.debug)
+
+	// Users are referenced by account id on cloud and by username on local installations.
+	params.assignee = cmdcommon.GetRelevantUser(client, project, params.assignee)
+
+	if len(params.keys) > 1 {
+		bulkMove(client, params, installation)
+		return
+	}
+
 	mc := moveCmd{
 		client:      client,
 		transitions: nil,
@@ -71,8 +87,7 @@ func move(cmd *cobra.Command, args []string) {
 	tr, err := mc.verifyTransition(installation)
 	if err != nil {
 		fmt.Println()
-		cmdutil.Failed("Error: %s", err.Error())
-		return
+		cmdutil.ExitIfError(err)
 	}
 
 	err = func() error {
@@ -84,8 +99,14 @@ func move(cmd *cobra.Command, args []string) {
 
 		if mc.params.assignee != "" {
 			trFieldsReq.Assignee = &struct {
-				Name string `json:"name"`
-			}{Name: mc.params.assignee}
+				Name      string `json:"name,omitempty"`
+				AccountID string `json:"accountId,omitempty"`
+			}{}
+			if installation == jira.InstallationTypeLocal {
+				trFieldsReq.Assignee.Name = mc.params.assignee
+			} else {
+				trFieldsReq.Assignee.AccountID = mc.params.assignee
+			}
 		}
 		if mc.params.resolution != "" {
 			trFieldsReq.Resolution = &struct {
@@ -100,7 +121,7 @@ func move(cmd *cobra.Command, args []string) {
 			}{
 				{Add: struct {
 					Body string `json:"body"`
-				}{Body: mc.params.comment}},
+				}{Body: md.ToJiraMD(mc.params.comment)}},
 			}
 		}
 
@@ -127,8 +148,41 @@ func move(cmd *cobra.Command, args []string) {
 	}
 }
 
+// bulkMove transitions multiple issues to the given state and reports a summary.
+// It continues on individual failures and exits with failure if any issue failed.
+func bulkMove(client *jira.Client, params *moveParams, installation string) {
+	if params.state == "" {
+		cmdutil.Failed("State is required when moving multiple issues, use --to to set one")
+	}
+
+	results, err := func() ([]jira.BulkResult, error) {
+		s := cmdutil.NewProgress(fmt.Sprintf("Transitioning %d issues to %q...", len(params.keys), params.state), len(params.keys))
+		defer s.Stop()
+
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
+
+		ctx = jira.WithProgress(ctx, s.Update)
+
+		return client.BulkTransition(ctx, params.keys, params.state, jira.TransitionOptions{
+			Comment:      params.comment,
+			Assignee:     params.assignee,
+			Resolution:   params.resolution,
+			Installation: installation,
+		})
+	}()
+	cmdutil.ExitIfError(err)
+
+	passed, err := cmdutil.BulkError(results)
+	if passed > 0 {
+		cmdutil.Success("%d of %d issues transitioned to state %q", passed, len(results), params.state)
+	}
+	cmdutil.ExitIfError(err)
+}
+
 type moveParams struct {
 	key        string
+	keys       []string
 	state      string
 	comment    string
 	assignee   string
@@ -137,16 +191,30 @@ type moveParams struct {
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *moveParams {
-	var key, state string
+	var (
+		key, state string
+		keys       []string
+	)
 
 	nargs := len(args)
 	if nargs >= 1 {
-		key = cmdutil.GetJiraIssueKey(project, args[0])
+		keys = cmdutil.GetJiraIssueKeys(project, args[0])
+		cmdutil.ExitIfError(cmdutil.ValidateIssueKeys(keys...))
+		if len(keys) > 0 {
+			key = keys[0]
+		}
 	}
 	if nargs >= 2 {
 		state = args[1]
 	}
 
+	to, err := flags.GetString("to")
+	cmdutil.ExitIfError(err)
+
+	if to != "" {
+		state = to
+	}
+
 	comment, err := flags.GetString("comment")
 	cmdutil.ExitIfError(err)
 
@@ -161,6 +229,7 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *m
 
 	return &moveParams{
 		key:        key,
+		keys:       keys,
 		state:      state,
 		comment:    comment,
 		assignee:   assignee,
@@ -255,19 +324,19 @@ func (mc *moveCmd) verifyTransition(it string) (*jira.Transition, error) {
 	}
 
 	if tr == nil {
-		return nil, fmt.Errorf(
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf(
 			"invalid transition state %q\nAvailable states for issue %s: %s",
 			mc.params.state, mc.params.key, strings.Join(all, ", "),
-		)
+		)}
 	}
 
 	// Jira API v2 doesn't seem to return "isAvailable" field even if the documentation says it does.
 	// So, we will only verify if the transition is available for the cloud installation.
 	if it == jira.InstallationTypeCloud && !tr.IsAvailable {
-		return nil, fmt.Errorf(
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf(
 			"transition state %q for issue %q is not available",
 			mc.params.state, mc.params.key,
-		)
+		)}
 	}
 	return tr, nil
 }
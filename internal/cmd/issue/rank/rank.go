@@ -0,0 +1,423 @@
+package rank
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Rank changes the order of issues on the board and backlog.
+
+Issues are ranked before or after the given issue and keep the order they are
+passed in. Pass - instead of the keys to read them from stdin, one per line or
+comma separated. Use '--position' to move the issues to the given position within a
+board or a sprint instead, or '--from-file' to rank issues listed in a file, one
+key per line, in that exact order. Pass '--board' to rank the issues using the rank
+field configured for the board. If some of the issues fail to rank, re-run the command with the
+'--skip-ranked' flag to rank only the issues that are not in the desired position yet.`
+	examples = `$ jira issue rank ISSUE-1 --before ISSUE-5
+
+# Rank multiple issues after an issue, ISSUE-2 will be ranked right after ISSUE-1
+$ jira issue rank ISSUE-1,ISSUE-2 --after ISSUE-5
+
+# Rank issues read from stdin, one per line or comma separated
+$ jira issue list --plain --no-headers --columns key | jira issue rank - --after ISSUE-9
+
+# Rank an issue within the board, board can be an ID or a name
+$ jira issue rank ISSUE-1 --before ISSUE-5 --board "Team board"
+
+# Make ISSUE-1 the 3rd issue in the sprint
+$ jira issue rank ISSUE-1 --position 3 --sprint 42
+
+# Rank issues to match the order in the file using as few rank calls as possible
+$ jira issue rank --from-file order.txt
+
+# Skip issues that are already in the desired position, useful to retry a partial failure
+$ jira issue rank ISSUE-1,ISSUE-2,ISSUE-3 --before ISSUE-5 --skip-ranked`
+)
+
+// NewCmdRank is a rank command.
+func NewCmdRank() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "rank [ISSUE-KEY]",
+		Short:   "Rank issues before or after an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1, a comma separated list of keys, eg: ISSUE-1,ISSUE-2, or - to read keys from stdin",
+		},
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               rank,
+	}
+
+	cmd.Flags().String("before", "", "Rank issues before the given issue")
+	cmd.Flags().String("after", "", "Rank issues after the given issue")
+	cmd.Flags().Uint("position", 0, "Rank issues at the given position, starting from 1, within a board or a sprint")
+	cmd.Flags().String("board", "", "ID or name of the board to rank issues in and look up the position in")
+	cmd.Flags().Uint("sprint", 0, "ID of the sprint to look up the position in")
+	cmd.Flags().String("from-file", "", "Rank issues to match the order of keys listed in the file")
+	cmd.Flags().Bool("skip-ranked", false, "Skip issues that are already in the desired position")
+	cmd.Flags().Uint("field-id", 0, "Custom rank field id, default rank field is used if not set")
+
+	cmd.MarkFlagsMutuallyExclusive("before", "after", "position", "from-file")
+	cmd.MarkFlagsOneRequired("before", "after", "position", "from-file")
+	cmd.MarkFlagsMutuallyExclusive("board", "sprint")
+
+	return &cmd
+}
+
+// rankClient is the part of the jira client used to resolve the board and the position to rank at.
+type rankClient interface {
+	GetSprintIssues(sprintID int, jql string) (*jira.SearchResult, error)
+	GetBoardIssues(boardID int, jql string) (*jira.SearchResult, error)
+	GetBoards(project string) ([]*jira.Board, error)
+}
+
+func rank(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	params, err := parseArgsAndFlags(cmd.Flags(), args, project)
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(params.debug)
+
+	if params.fromFile != "" {
+		rankToOrder(client, params)
+		return
+	}
+
+	if params.boardName != "" {
+		params.board, err = func() (uint, error) {
+			s := cmdutil.Info("Fetching boards...")
+			defer s.Stop()
+
+			return resolveBoard(client, project, params.boardName)
+		}()
+		cmdutil.ExitIfError(err)
+	}
+
+	if params.position > 0 {
+		params.before, params.after, err = func() (string, string, error) {
+			s := cmdutil.Info("Fetching current order...")
+			defer s.Stop()
+
+			return resolvePosition(client, params)
+		}()
+		cmdutil.ExitIfError(err)
+	}
+
+	opts := jira.RankOptions{
+		Before:        params.before,
+		After:         params.after,
+		CustomFieldID: int(params.fieldID),
+		BoardID:       int(params.board),
+		SkipRanked:    params.skipRanked,
+	}
+
+	result, err := func() (*jira.RankResult, error) {
+		s := cmdutil.NewProgress(fmt.Sprintf("Ranking %d issues...", len(params.keys)), len(params.keys))
+		defer s.Stop()
+
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
+
+		return client.RankIssuesCtx(jira.WithProgress(ctx, s.Update), params.keys, opts)
+	}()
+	if result != nil {
+		printResult(params, result)
+	}
+	cmdutil.ExitIfError(rankError(err))
+
+	exitIfFailed(params, result)
+}
+
+func rankToOrder(client *jira.Client, params *rankParams) {
+	result, err := func() (*jira.RankResult, error) {
+		s := cmdutil.NewProgress(fmt.Sprintf("Ranking %d issues to match the order in %s...", len(params.keys), params.fromFile), len(params.keys))
+		defer s.Stop()
+
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
+
+		return client.RankToOrderCtx(jira.WithProgress(ctx, s.Update), params.keys)
+	}()
+	if result != nil {
+		if len(result.Ranked) == 0 && len(result.Failed) == 0 {
+			cmdutil.Success("Issues are already in the desired order")
+			return
+		}
+		if len(result.Ranked) > 0 {
+			cmdutil.Success("Ranked %d issues to match the order in %s: %s", len(result.Ranked), params.fromFile, strings.Join(result.Ranked, ", "))
+		}
+	}
+	cmdutil.ExitIfError(rankError(err))
+
+	exitIfFailed(params, result)
+}
+
+// rankError adds a hint on how to configure the Jira Agile endpoint to the error.
+func rankError(err error) error {
+	if errors.Is(err, jira.ErrNoAgileEndpoint) {
+		return &cmdutil.ValidationError{
+			Msg: fmt.Sprintf("%s; run 'jira init --agile-endpoint URL' or set 'agile_endpoint' in the config", err),
+		}
+	}
+	return err
+}
+
+func exitIfFailed(params *rankParams, result *jira.RankResult) {
+	if len(result.Failed) == 0 {
+		return
+	}
+
+	var msg strings.Builder
+	for _, k := range result.FailedKeys(params.keys) {
+		msg.WriteString(fmt.Sprintf("\n  - %s: %s", k, result.Failed[k]))
+	}
+	cmdutil.Failed(
+		"Unable to rank %d issues:%s\n\nRetry with: %s",
+		len(result.Failed), msg.String(), retryCommand(params),
+	)
+}
+
+// resolveBoard finds the ID of the board with the given name in the project.
+func resolveBoard(client rankClient, project, name string) (uint, error) {
+	boards, err := client.GetBoards(project)
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range boards {
+		if strings.EqualFold(b.Name, name) {
+			return uint(b.ID), nil
+		}
+	}
+	return 0, &cmdutil.ValidationError{Msg: fmt.Sprintf("board %q not found in project %s", name, project)}
+}
+
+// resolvePosition translates the position within a board or a sprint to the
+// issue to rank before or after.
+func resolvePosition(client rankClient, params *rankParams) (string, string, error) {
+	var (
+		res *jira.SearchResult
+		err error
+	)
+
+	if params.sprint > 0 {
+		res, err = client.GetSprintIssues(int(params.sprint), "ORDER BY Rank ASC")
+	} else {
+		res, err = client.GetBoardIssues(int(params.board), "ORDER BY Rank ASC")
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	order := make([]string, 0, len(res.Issues))
+	for _, iss := range res.Issues {
+		order = append(order, iss.Key)
+	}
+
+	ref, err := jira.RankPosition(order, params.keys, int(params.position))
+	if err != nil {
+		return "", "", &cmdutil.ValidationError{Msg: fmt.Sprintf("unable to rank at position %d: %s", params.position, err)}
+	}
+	return ref.Before, ref.After, nil
+}
+
+func printResult(params *rankParams, result *jira.RankResult) {
+	position := fmt.Sprintf("before %s", params.before)
+	if params.after != "" {
+		position = fmt.Sprintf("after %s", params.after)
+	}
+
+	if len(result.Skipped) > 0 {
+		cmdutil.Warn("Skipped %d issues already ranked %s: %s", len(result.Skipped), position, strings.Join(result.Skipped, ", "))
+	}
+	if len(result.Ranked) > 0 {
+		cmdutil.Success("Ranked %d issues %s: %s", len(result.Ranked), position, strings.Join(result.Ranked, ", "))
+	}
+}
+
+// retryCommand builds a command to rank the issues again skipping the ones
+// that are already in place so that only the failed ones are moved.
+func retryCommand(params *rankParams) string {
+	if params.fromFile != "" {
+		return fmt.Sprintf("jira issue rank --from-file %s", params.fromFile)
+	}
+
+	position := fmt.Sprintf("--before %s", params.before)
+	if params.after != "" {
+		position = fmt.Sprintf("--after %s", params.after)
+	}
+	if params.board > 0 {
+		position += fmt.Sprintf(" --board %d", params.board)
+	}
+	return fmt.Sprintf("jira issue rank %s %s --skip-ranked", strings.Join(params.keys, ","), position)
+}
+
+type rankParams struct {
+	keys       []string
+	before     string
+	after      string
+	position   uint
+	board      uint
+	boardName  string
+	sprint     uint
+	fromFile   string
+	skipRanked bool
+	fieldID    uint
+	debug      bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) (*rankParams, error) {
+	before, err := flags.GetString("before")
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := flags.GetString("after")
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := flags.GetUint("position")
+	if err != nil {
+		return nil, err
+	}
+
+	boardFlag, err := flags.GetString("board")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		board     uint
+		boardName = strings.TrimSpace(boardFlag)
+	)
+	if id, err := strconv.ParseUint(boardName, 10, 0); err == nil {
+		board, boardName = uint(id), ""
+	}
+
+	sprint, err := flags.GetUint("sprint")
+	if err != nil {
+		return nil, err
+	}
+
+	fromFile, err := flags.GetString("from-file")
+	if err != nil {
+		return nil, err
+	}
+
+	skipRanked, err := flags.GetBool("skip-ranked")
+	if err != nil {
+		return nil, err
+	}
+
+	fieldID, err := flags.GetUint("field-id")
+	if err != nil {
+		return nil, err
+	}
+
+	debug, err := flags.GetBool("debug")
+	if err != nil {
+		return nil, err
+	}
+
+	if before != "" {
+		before = cmdutil.GetJiraIssueKey(project, before)
+		if err := cmdutil.ValidateIssueKeys(before); err != nil {
+			return nil, err
+		}
+	}
+	if after != "" {
+		after = cmdutil.GetJiraIssueKey(project, after)
+		if err := cmdutil.ValidateIssueKeys(after); err != nil {
+			return nil, err
+		}
+	}
+	if position > 0 && board == 0 && boardName == "" && sprint == 0 {
+		return nil, &cmdutil.ValidationError{Msg: "either '--board' or '--sprint' is required with '--position'"}
+	}
+
+	var keys []string
+
+	switch {
+	case fromFile != "":
+		if len(args) > 0 {
+			return nil, &cmdutil.ValidationError{Msg: "issue keys can't be passed as an argument with '--from-file'"}
+		}
+		if board > 0 || boardName != "" {
+			return nil, &cmdutil.ValidationError{Msg: "'--board' can't be used with '--from-file'"}
+		}
+		if keys, err = readKeys(fromFile, project); err != nil {
+			return nil, err
+		}
+	case len(args) > 0 && args[0] == "-":
+		if keys, err = readKeys("-", project); err != nil {
+			return nil, err
+		}
+	case len(args) > 0:
+		keys = cmdutil.GetJiraIssueKeys(project, args[0])
+	}
+	if len(keys) == 0 {
+		return nil, &cmdutil.ValidationError{Msg: "issue key is required"}
+	}
+	if err := cmdutil.ValidateIssueKeys(keys...); err != nil {
+		return nil, err
+	}
+
+	ref := before + after
+	for _, k := range keys {
+		if k == ref {
+			return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("issue %s can't be ranked relative to itself", k)}
+		}
+	}
+
+	return &rankParams{
+		keys:       keys,
+		before:     before,
+		after:      after,
+		position:   position,
+		board:      board,
+		boardName:  boardName,
+		sprint:     sprint,
+		fromFile:   fromFile,
+		skipRanked: skipRanked,
+		fieldID:    fieldID,
+		debug:      debug,
+	}, nil
+}
+
+// readKeys reads issue keys from the file, use - to read them from stdin.
+func readKeys(file, project string) ([]string, error) {
+	b, err := cmdutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return parseKeys(string(b), project), nil
+}
+
+// parseKeys parses issue keys listed one per line or comma separated.
+// Empty lines and lines starting with # are ignored.
+func parseKeys(s, project string) []string {
+	var keys []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keys = append(keys, cmdutil.GetJiraIssueKeys(project, line)...)
+	}
+	return keys
+}
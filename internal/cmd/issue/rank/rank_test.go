@@ -0,0 +1,279 @@
+package rank
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+type fakeRankClient struct {
+	sprintID int
+	boardID  int
+	issues   []string
+	boards   []*jira.Board
+	err      error
+}
+
+func (f *fakeRankClient) GetSprintIssues(sprintID int, _ string) (*jira.SearchResult, error) {
+	f.sprintID = sprintID
+	return f.result()
+}
+
+func (f *fakeRankClient) GetBoardIssues(boardID int, _ string) (*jira.SearchResult, error) {
+	f.boardID = boardID
+	return f.result()
+}
+
+func (f *fakeRankClient) GetBoards(string) ([]*jira.Board, error) {
+	return f.boards, f.err
+}
+
+func (f *fakeRankClient) result() (*jira.SearchResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	res := jira.SearchResult{Total: len(f.issues)}
+	for _, k := range f.issues {
+		res.Issues = append(res.Issues, &jira.Issue{Key: k})
+	}
+	return &res, nil
+}
+
+func parse(t *testing.T, args []string, flags map[string]string) (*rankParams, error) {
+	t.Helper()
+
+	cmd := NewCmdRank()
+	cmd.Flags().Bool("debug", false, "")
+	for k, v := range flags {
+		assert.NoError(t, cmd.Flags().Set(k, v))
+	}
+	return parseArgsAndFlags(cmd.Flags(), args, "TEST")
+}
+
+func TestParseArgsAndFlags(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "order.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("# order\nTEST-3\n\n2\n"), 0o600))
+
+	cases := []struct {
+		name      string
+		args      []string
+		flags     map[string]string
+		keys      []string
+		before    string
+		after     string
+		board     uint
+		boardName string
+		err       string
+	}{
+		{
+			name:   "it ranks a single issue before another",
+			args:   []string{"TEST-1"},
+			flags:  map[string]string{"before": "TEST-5"},
+			keys:   []string{"TEST-1"},
+			before: "TEST-5",
+		},
+		{
+			name:  "it prefixes numeric keys with the project",
+			args:  []string{"1,test-2"},
+			flags: map[string]string{"after": "5"},
+			keys:  []string{"TEST-1", "TEST-2"},
+			after: "TEST-5",
+		},
+		{
+			name:   "it trims whitespace around the keys",
+			args:   []string{"TEST-1, TEST-2 ,"},
+			flags:  map[string]string{"before": " TEST-5 "},
+			keys:   []string{"TEST-1", "TEST-2"},
+			before: "TEST-5",
+		},
+		{
+			name:  "it reads keys from the file",
+			flags: map[string]string{"from-file": file},
+			keys:  []string{"TEST-3", "TEST-2"},
+		},
+		{
+			name:   "it ranks an issue within a board by id",
+			args:   []string{"TEST-1"},
+			flags:  map[string]string{"before": "TEST-5", "board": "7"},
+			keys:   []string{"TEST-1"},
+			before: "TEST-5",
+			board:  7,
+		},
+		{
+			name:      "it ranks an issue within a board by name",
+			args:      []string{"TEST-1"},
+			flags:     map[string]string{"position": "2", "board": " Team board "},
+			keys:      []string{"TEST-1"},
+			boardName: "Team board",
+		},
+		{
+			name:  "it fails without keys",
+			flags: map[string]string{"before": "TEST-5"},
+			err:   "issue key is required",
+		},
+		{
+			name:  "it fails for an empty key argument",
+			args:  []string{""},
+			flags: map[string]string{"before": "TEST-5"},
+			err:   "issue key is required",
+		},
+		{
+			name:  "it fails for a list of empty keys",
+			args:  []string{" , ,"},
+			flags: map[string]string{"after": "TEST-5"},
+			err:   "issue key is required",
+		},
+		{
+			name:  "it fails for an invalid key",
+			args:  []string{"TEST-1,oops"},
+			flags: map[string]string{"before": "TEST-5"},
+			err:   "invalid issue key: OOPS",
+		},
+		{
+			name:  "it fails for an invalid reference issue",
+			args:  []string{"TEST-1"},
+			flags: map[string]string{"after": "TEST"},
+			err:   "invalid issue key: TEST",
+		},
+		{
+			name:  "it fails to rank an issue relative to itself",
+			args:  []string{"TEST-1,TEST-2"},
+			flags: map[string]string{"before": "TEST-2"},
+			err:   "issue TEST-2 can't be ranked relative to itself",
+		},
+		{
+			name:  "it fails for position without a board or a sprint",
+			args:  []string{"TEST-1"},
+			flags: map[string]string{"position": "2"},
+			err:   "either '--board' or '--sprint' is required with '--position'",
+		},
+		{
+			name:  "it fails for keys passed along with a file",
+			args:  []string{"TEST-1"},
+			flags: map[string]string{"from-file": file},
+			err:   "issue keys can't be passed as an argument with '--from-file'",
+		},
+		{
+			name:  "it fails for a board along with a file",
+			flags: map[string]string{"from-file": file, "board": "7"},
+			err:   "'--board' can't be used with '--from-file'",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			params, err := parse(t, tc.args, tc.flags)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+
+				var verr *cmdutil.ValidationError
+				assert.ErrorAs(t, err, &verr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.keys, params.keys)
+			assert.Equal(t, tc.before, params.before)
+			assert.Equal(t, tc.after, params.after)
+			assert.Equal(t, tc.board, params.board)
+			assert.Equal(t, tc.boardName, params.boardName)
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		[]string{"TEST-1", "TEST-2", "TEST-3", "TEST-4"},
+		parseKeys("# keys\nTEST-1\n\n 2, TEST-3\ntest-4\n", "TEST"),
+	)
+	assert.Empty(t, parseKeys("\n\n", "TEST"))
+}
+
+func TestResolveBoard(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeRankClient{boards: []*jira.Board{{ID: 1, Name: "Other board"}, {ID: 7, Name: "Team Board"}}}
+
+	id, err := resolveBoard(client, "TEST", "team board")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), id)
+
+	_, err = resolveBoard(client, "TEST", "Missing")
+	assert.EqualError(t, err, `board "Missing" not found in project TEST`)
+
+	var verr *cmdutil.ValidationError
+	assert.ErrorAs(t, err, &verr)
+
+	client.err = errors.New("boom")
+	_, err = resolveBoard(client, "TEST", "Team Board")
+	assert.EqualError(t, err, "boom")
+}
+
+func TestResolvePosition(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeRankClient{issues: []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4"}}
+
+	before, after, err := resolvePosition(client, &rankParams{keys: []string{"TEST-4"}, position: 2, sprint: 42})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, client.sprintID)
+	assert.Equal(t, "TEST-2", before)
+	assert.Empty(t, after)
+
+	before, after, err = resolvePosition(client, &rankParams{keys: []string{"TEST-1"}, position: 4, board: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, client.boardID)
+	assert.Empty(t, before)
+	assert.Equal(t, "TEST-4", after)
+
+	_, _, err = resolvePosition(client, &rankParams{keys: []string{"TEST-1"}, position: 9, board: 7})
+	assert.EqualError(t, err, "unable to rank at position 9: position 9 is out of range, valid range is 1-4")
+
+	client.err = errors.New("boom")
+	_, _, err = resolvePosition(client, &rankParams{keys: []string{"TEST-1"}, position: 1, board: 7})
+	assert.EqualError(t, err, "boom")
+}
+
+func TestRankError(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, rankError(nil))
+	assert.EqualError(t, rankError(errors.New("boom")), "boom")
+
+	err := rankError(jira.ErrNoAgileEndpoint)
+	assert.EqualError(t, err, "jira: agile endpoint not configured; run 'jira init --agile-endpoint URL' or set 'agile_endpoint' in the config")
+
+	var verr *cmdutil.ValidationError
+	assert.ErrorAs(t, err, &verr)
+}
+
+func TestRetryCommand(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"jira issue rank TEST-1,TEST-2 --after TEST-5 --skip-ranked",
+		retryCommand(&rankParams{keys: []string{"TEST-1", "TEST-2"}, after: "TEST-5"}),
+	)
+	assert.Equal(t,
+		"jira issue rank TEST-1 --before TEST-5 --board 7 --skip-ranked",
+		retryCommand(&rankParams{keys: []string{"TEST-1"}, before: "TEST-5", board: 7}),
+	)
+	assert.Equal(t,
+		"jira issue rank --from-file order.txt",
+		retryCommand(&rankParams{keys: []string{"TEST-1"}, fromFile: "order.txt"}),
+	)
+}
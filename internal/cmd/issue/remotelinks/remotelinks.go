@@ -0,0 +1,88 @@
+package remotelinks
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Remotelinks lists remote web links of an issue.
+
+Use --delete to remove a remote link using the id from the list.`
+	examples = `$ jira issue remotelinks ISSUE-1
+
+# Delete a remote link
+$ jira issue remotelinks ISSUE-1 --delete 10000`
+)
+
+// NewCmdRemoteLinks is a remotelinks command.
+func NewCmdRemoteLinks() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "remotelinks ISSUE-KEY",
+		Short:   "Remotelinks lists and deletes remote links of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remotelink", "rl"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               remoteLinks,
+	}
+
+	cmd.Flags().String("delete", "", "Id of the remote link to delete")
+
+	return &cmd
+}
+
+func remoteLinks(cmd *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	del, err := cmd.Flags().GetString("delete")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	if del != "" {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Deleting remote link %s from issue %s...", del, key))
+			defer s.Stop()
+
+			return client.DeleteRemoteLink(key, del)
+		}()
+		cmdutil.ExitIfError(err)
+
+		cmdutil.Success("Remote link %s deleted from issue %s", del, key)
+		return
+	}
+
+	links, err := func() ([]*jira.RemoteLink, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching remote links of issue %s...", key))
+		defer s.Stop()
+
+		return client.GetRemoteLinks(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(links) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No remote links found for issue %q", key)
+		return
+	}
+
+	v := view.NewRemoteLink(links)
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,57 @@
+package restore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Restore brings back an archived issue.
+
+Restoring is only available in Jira cloud Premium and Enterprise plans.`
+	examples = `$ jira issue restore ISSUE-1`
+)
+
+// NewCmdRestore is a restore command.
+func NewCmdRestore() *cobra.Command {
+	return &cobra.Command{
+		Use:     "restore ISSUE-KEY",
+		Short:   "Restore an archived issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"unarchive"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  restore,
+	}
+}
+
+func restore(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Restoring issue %q...", key))
+		defer s.Stop()
+
+		return client.RestoreIssue(key)
+	}()
+	if errors.Is(err, jira.ErrPremiumRequired) {
+		cmdutil.Failed("Unable to restore issue: restoring is only available in Jira cloud Premium and Enterprise plans")
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Issue %q restored\n%s", key, cmdutil.GenerateServerBrowseURL(viper.GetString("server"), key))
+}
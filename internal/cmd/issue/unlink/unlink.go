@@ -1,6 +1,7 @@
 package unlink
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -8,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -30,7 +32,8 @@ func NewCmdUnlink() *cobra.Command {
 			"help:args": "INWARD_ISSUE_KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
 				"OUTWARD_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2.",
 		},
-		Run: unlink,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(2),
+		Run:               unlink,
 	}
 
 	cmd.Flags().Bool("web", false, "Open inward issue in web browser after successful unlinking")
@@ -61,6 +64,16 @@ func unlink(cmd *cobra.Command, args []string) {
 
 		return client.UnlinkIssue(linkID)
 	}()
+
+	switch {
+	case errors.Is(err, jira.ErrNoLink):
+		cmdutil.Failed("Issues %q and %q are not linked", uc.params.inwardIssueKey, uc.params.outwardIssueKey)
+	case errors.Is(err, jira.ErrHierarchyLink):
+		cmdutil.Failed(
+			"Issues %q and %q are related as parent and sub-task\nThis is an issue hierarchy and not a link, so it cannot be unlinked",
+			uc.params.inwardIssueKey, uc.params.outwardIssueKey,
+		)
+	}
 	cmdutil.ExitIfError(err)
 
 	server := viper.GetString("server")
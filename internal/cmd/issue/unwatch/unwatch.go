@@ -0,0 +1,78 @@
+package unwatch
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Unwatch removes the current user from issue watchers.`
+	examples = `$ jira issue unwatch ISSUE-1
+
+# Stop watching multiple issues at once
+$ jira issue unwatch ISSUE-1,ISSUE-2,ISSUE-3`
+)
+
+// NewCmdUnwatch is an unwatch command.
+func NewCmdUnwatch() *cobra.Command {
+	return &cobra.Command{
+		Use:     "unwatch ISSUE-KEY",
+		Short:   "Remove current user from issue watchers",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1, or a comma separated list of keys, eg: ISSUE-1,ISSUE-2",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               unwatch,
+	}
+}
+
+func unwatch(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	keys := cmdutil.GetJiraIssueKeys(project, args[0])
+	if len(keys) == 0 {
+		cmdutil.Failed("Issue key is required")
+	}
+	cmdutil.ExitIfError(cmdutil.ValidateIssueKeys(keys...))
+
+	client := api.DefaultClient(debug)
+
+	results, err := func() ([]jira.BulkResult, error) {
+		s := cmdutil.NewProgress(fmt.Sprintf("Removing current user from watchers of %d issue(s)...", len(keys)), len(keys))
+		defer s.Stop()
+
+		ctx, cancel := cmdutil.InterruptContext()
+		defer cancel()
+
+		ctx = jira.WithProgress(ctx, s.Update)
+
+		me, err := client.Me()
+		if err != nil {
+			return nil, err
+		}
+
+		user := jira.User{AccountID: me.AccountID, Name: me.Login}
+
+		return api.ProxyBulkUnwatch(ctx, client, keys, &user), nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	passed, err := cmdutil.BulkError(results)
+	if passed > 0 {
+		cmdutil.Success("Stopped watching %d of %d issue(s)", passed, len(results))
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -2,14 +2,17 @@ package view
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
 )
 
@@ -20,13 +23,26 @@ const (
 # Show 5 recent comments when viewing the issue
 $ jira issue view ISSUE-1 --comments 5
 
+# Show the last 10 comments, oldest first
+$ jira issue view ISSUE-1 --comments 10 --comments-order asc
+
 # Get the raw JSON data
-$ jira issue view ISSUE-1 --raw`
+$ jira issue view ISSUE-1 --raw
+
+# Only fetch a few fields, useful to reduce the payload when polling in scripts
+$ jira issue view ISSUE-1 --raw --fields summary,status
+
+# Print compact JSON of a single value, eg: to use in tight loops
+$ jira issue view ISSUE-1 --raw --compact --path .fields.status.name`
 
 	flagRaw      = "raw"
 	flagDebug    = "debug"
 	flagComments = "comments"
+	flagOrder    = "comments-order"
 	flagPlain    = "plain"
+	flagFields   = "fields"
+	flagCompact  = "compact"
+	flagPath     = "path"
 
 	configProject = "project.key"
 	configServer  = "server"
@@ -45,13 +61,18 @@ func NewCmdView() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  view,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               view,
 	}
 
-	cmd.Flags().Uint(flagComments, 1, "Show N comments")
+	cmd.Flags().Uint(flagComments, 1, "Show last N comments")
+	cmd.Flags().String(flagOrder, tuiView.CommentsOrderDesc, "Order of the shown comments: asc (oldest first) or desc (newest first)")
 	cmd.Flags().Bool(flagPlain, false, "Display output in plain mode")
 	cmd.Flags().Bool(flagRaw, false, "Print raw Jira API response")
+	cmd.Flags().String(flagFields, "", "Comma separated list of field ids or names to fetch, all fields are fetched by default")
+	cmd.Flags().Bool(flagCompact, false, "Print raw JSON in a single line instead of indented, works with --raw")
+	cmd.Flags().String(flagPath, "", "Only print the value at the given jq-like path, eg: .fields.status.name, works with --raw")
 
 	return &cmd
 }
@@ -72,17 +93,27 @@ func viewRaw(cmd *cobra.Command, args []string) {
 	cmdutil.ExitIfError(err)
 
 	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	client := api.DefaultClient(debug)
+	opts := fieldsFilter(cmd, client)
 
 	apiResp, err := func() (string, error) {
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
-		client := api.DefaultClient(debug)
-		return api.ProxyGetIssueRaw(client, key)
+		return api.ProxyGetIssueRaw(client, key, opts...)
 	}()
 	cmdutil.ExitIfError(err)
 
-	fmt.Println(apiResp)
+	compact, err := cmd.Flags().GetBool(flagCompact)
+	cmdutil.ExitIfError(err)
+
+	path, err := cmd.Flags().GetString(flagPath)
+	cmdutil.ExitIfError(err)
+
+	out, err := cmdutil.FormatJSON(apiResp, compact, path)
+	cmdutil.ExitIfError(err)
+
+	fmt.Println(out)
 }
 
 func viewPretty(cmd *cobra.Command, args []string) {
@@ -98,13 +129,25 @@ func viewPretty(cmd *cobra.Command, args []string) {
 		comments = max(numComments, 1)
 	}
 
+	order, err := cmd.Flags().GetString(flagOrder)
+	cmdutil.ExitIfError(err)
+
+	order = strings.ToLower(order)
+	if order != tuiView.CommentsOrderAsc && order != tuiView.CommentsOrderDesc {
+		cmdutil.ExitIfError(&cmdutil.ValidationError{
+			Msg: fmt.Sprintf("invalid comments order %q, must be one of: asc, desc", order),
+		})
+	}
+
 	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	client := api.DefaultClient(debug)
+	opts := append(fieldsFilter(cmd, client), issue.NewNumCommentsFilter(comments))
+
 	iss, err := func() (*jira.Issue, error) {
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
-		client := api.DefaultClient(debug)
-		return api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(comments))
+		return api.ProxyGetIssue(client, key, opts...)
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -112,10 +155,75 @@ func viewPretty(cmd *cobra.Command, args []string) {
 	cmdutil.ExitIfError(err)
 
 	v := tuiView.Issue{
-		Server:  viper.GetString(configServer),
-		Data:    iss,
-		Display: tuiView.DisplayFormat{Plain: plain},
-		Options: tuiView.IssueOption{NumComments: comments},
+		Server: viper.GetString(configServer),
+		Data:   iss,
+		Display: tuiView.DisplayFormat{
+			Plain:      plain,
+			Timezone:   viper.GetString("timezone"),
+			DateFormat: viper.GetString("date_format"),
+		},
+		Options: tuiView.IssueOption{NumComments: comments, CommentsOrder: order},
 	}
 	cmdutil.ExitIfError(v.Render())
 }
+
+// fieldsFilter validates fields passed with the fields flag against the fields configured
+// in the Jira instance and returns a filter to only fetch those. Field names are translated
+// to ids, and special values like *all or fields excluded with a minus (-) are kept as is.
+func fieldsFilter(cmd *cobra.Command, client *jira.Client) []filter.Filter {
+	fields, err := cmd.Flags().GetString(flagFields)
+	cmdutil.ExitIfError(err)
+
+	if fields == "" {
+		return nil
+	}
+
+	available, err := func() ([]*jira.Field, error) {
+		s := cmdutil.Info("Validating fields...")
+		defer s.Stop()
+
+		return client.GetField()
+	}()
+	cmdutil.ExitIfError(err)
+
+	ids := make(map[string]string, len(available)*2)
+	for _, f := range available {
+		ids[f.ID] = f.ID
+		ids[strings.ToLower(f.Name)] = f.ID
+	}
+
+	var (
+		out     []string
+		unknown []string
+	)
+	for _, f := range strings.Split(fields, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		if strings.HasPrefix(f, "*") {
+			out = append(out, f)
+			continue
+		}
+
+		prefix, name := "", f
+		if strings.HasPrefix(f, "-") {
+			prefix, name = "-", f[1:]
+		}
+
+		id, ok := ids[name]
+		if !ok {
+			id, ok = ids[strings.ToLower(name)]
+		}
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		out = append(out, prefix+id)
+	}
+	if len(unknown) > 0 {
+		cmdutil.Failed("Unknown fields: %s", strings.Join(unknown, ", "))
+	}
+
+	return []filter.Filter{issue.NewFieldsFilter(out...)}
+}
@@ -11,20 +11,24 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Adds user to issue watchers.`
+	helpText = `Adds user to issue watchers. The current user is added if no watcher is given.`
 	examples = `$ jira issue watch ISSUE-1 jon@domain.tld
 
 # Watcher name or email needs to be an exact match
 $ jira issue watch ISSUE-1 "Jon Doe"
 
 # Add self to watchers
-$ jira issue watch ISSUE-1 $(jira me)`
+$ jira issue watch ISSUE-1
+
+# Watch multiple issues at once
+$ jira issue watch ISSUE-1,ISSUE-2`
 
 	maxResults = 100
 	lineBreak  = "----------"
@@ -36,16 +40,17 @@ $ jira issue watch ISSUE-1 $(jira me)`
 // NewCmdWatch is an watch command.
 func NewCmdWatch() *cobra.Command {
 	return &cobra.Command{
-		Use:     "watch ISSUE-KEY WATCHER",
+		Use:     "watch ISSUE-KEY [WATCHER]",
 		Short:   "Add user to issue watchers",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"wat"},
 		Annotations: map[string]string{
-			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
-WATCHER	Email or display name of the user to add to issue watchers`,
+			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1, or a comma separated list of keys, eg: ISSUE-1,ISSUE-2
+WATCHER	Email or display name of the user to add to issue watchers, defaults to the current user`,
 		},
-		Run: watch,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               watch,
 	}
 }
 
@@ -61,17 +66,44 @@ func watch(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(ac.setIssueKey(project))
 
-	cmdutil.ExitIfError(ac.setAvailableUsers(project))
-	cmdutil.ExitIfError(ac.setWatcher(project))
+	var (
+		u   *jira.User
+		err error
+	)
+	if ac.params.user == "" {
+		u, err = ac.currentUser()
+		cmdutil.ExitIfError(err)
+	} else {
+		cmdutil.ExitIfError(ac.setAvailableUsers(project))
+		cmdutil.ExitIfError(ac.setWatcher(project))
 
-	u, err := ac.verifyWatcher()
-	if err != nil {
-		cmdutil.Failed("Error: %s", err.Error())
-		return
+		u, err = ac.verifyWatcher()
+		cmdutil.ExitIfError(err)
 	}
 
 	uname := getQueryableName(u.DisplayName, u.Name)
 
+	if len(ac.params.keys) > 1 {
+		results := func() []jira.BulkResult {
+			s := cmdutil.NewProgress(fmt.Sprintf("Adding user %q as watcher of %d issues...", uname, len(ac.params.keys)), len(ac.params.keys))
+			defer s.Stop()
+
+			ctx, cancel := cmdutil.InterruptContext()
+			defer cancel()
+
+			ctx = jira.WithProgress(ctx, s.Update)
+
+			return api.ProxyBulkWatch(ctx, client, ac.params.keys, u)
+		}()
+
+		passed, err := cmdutil.BulkError(results)
+		if passed > 0 {
+			cmdutil.Success("User %q added as watcher of %d of %d issues", uname, passed, len(results))
+		}
+		cmdutil.ExitIfError(err)
+		return
+	}
+
 	err = func() error {
 		s := cmdutil.Info(fmt.Sprintf("Adding user %q as watcher of issue %q...", uname, ac.params.key))
 		defer s.Stop()
@@ -86,16 +118,24 @@ func watch(cmd *cobra.Command, args []string) {
 
 type watchParams struct {
 	key   string
+	keys  []string
 	user  string
 	debug bool
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *watchParams {
-	var key, user string
+	var (
+		key, user string
+		keys      []string
+	)
 
 	nargs := len(args)
 	if nargs >= 1 {
-		key = cmdutil.GetJiraIssueKey(project, args[0])
+		keys = cmdutil.GetJiraIssueKeys(project, args[0])
+		cmdutil.ExitIfError(cmdutil.ValidateIssueKeys(keys...))
+		if len(keys) > 0 {
+			key = keys[0]
+		}
 	}
 	if nargs >= 2 {
 		user = args[1]
@@ -106,6 +146,7 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *w
 
 	return &watchParams{
 		key:   key,
+		keys:  keys,
 		user:  user,
 		debug: debug,
 	}
@@ -266,6 +307,18 @@ func (ac *watchCmd) setAvailableUsers(project string) error {
 	return ac.searchAndSetUser(project)
 }
 
+// currentUser returns the user the command is run as.
+func (ac *watchCmd) currentUser() (*jira.User, error) {
+	s := cmdutil.Info("Fetching current user...")
+	defer s.Stop()
+
+	me, err := ac.client.Me()
+	if err != nil {
+		return nil, err
+	}
+	return &jira.User{AccountID: me.AccountID, Name: me.Login, DisplayName: me.Name, Active: true}, nil
+}
+
 func (ac *watchCmd) verifyWatcher() (*jira.User, error) {
 	watcher := strings.ToLower(ac.params.user)
 
@@ -285,10 +338,10 @@ func (ac *watchCmd) verifyWatcher() (*jira.User, error) {
 	}
 
 	if user == nil {
-		return nil, fmt.Errorf("invalid watcher %q", ac.params.user)
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("invalid watcher %q", ac.params.user)}
 	}
 	if !user.Active {
-		return nil, fmt.Errorf("user %q is not active", getQueryableName(user.Name, user.DisplayName))
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("user %q is not active", getQueryableName(user.Name, user.DisplayName))}
 	}
 	return user, nil
 }
@@ -49,7 +49,8 @@ func NewCmdWorklogAdd() *cobra.Command {
 			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
 				"TIME_SPENT\tTime to log as days (d), hours (h), or minutes (m), separated by space eg: 2d 1h 30m",
 		},
-		Run: add,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               add,
 	}
 
 	cmd.Flags().SortFlags = false
@@ -87,6 +88,16 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	timeSpent, err := jira.ParseJiraDuration(params.timeSpent)
+	cmdutil.ExitIfError(err)
+	params.timeSpent = timeSpent
+
+	if params.newEstimate != "" {
+		newEstimate, err := jira.ParseJiraDuration(params.newEstimate)
+		cmdutil.ExitIfError(err)
+		params.newEstimate = newEstimate
+	}
+
 	if !params.noInput {
 		answer := struct{ Action string }{}
 		err := survey.Ask([]*survey.Question{getNextAction()}, &answer)
@@ -97,17 +108,21 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
+	worklog, err := func() (*jira.Worklog, error) {
 		s := cmdutil.Info("Adding a worklog")
 		defer s.Stop()
 
-		return client.AddIssueWorklog(ac.params.issueKey, ac.params.started, ac.params.timeSpent, ac.params.comment, ac.params.newEstimate)
+		return client.AddIssueWorklogWithResult(ac.params.issueKey, ac.params.started, ac.params.timeSpent, ac.params.comment, ac.params.newEstimate)
 	}()
 	cmdutil.ExitIfError(err)
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Worklog added to issue %q", ac.params.issueKey)
+	if worklog.ID != "" {
+		cmdutil.Success("Worklog %s of %s added to issue %q", worklog.ID, ac.params.timeSpent, ac.params.issueKey)
+	} else {
+		cmdutil.Success("Worklog of %s added to issue %q", ac.params.timeSpent, ac.params.issueKey)
+	}
 	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
 }
 
@@ -201,7 +216,13 @@ func (ac *addCmd) getQuestions() []*survey.Question {
 				Message: "Time spent",
 				Help:    "Time to log as days (d), hours (h), or minutes (m), separated by space eg: 2d 1h 30m",
 			},
-			Validate: survey.Required,
+			Validate: func(val any) error {
+				if err := survey.Required(val); err != nil {
+					return err
+				}
+				_, err := jira.ParseJiraDuration(val.(string))
+				return err
+			},
 		})
 	}
 
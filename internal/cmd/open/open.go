@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/browser"
 )
@@ -13,7 +14,13 @@ import (
 const (
 	helpText = `Open opens issue in a browser. If the issue key is not given, it will open the project page.`
 	examples = `$ jira open
-$ jira open ISSUE-1`
+$ jira open ISSUE-1
+
+# Copy the issue URL to the clipboard instead of opening it
+$ jira open ISSUE-1 --copy
+
+# Only print the issue URL, eg: to use it in a script
+$ jira open ISSUE-1 --print-url`
 )
 
 // NewCmdOpen is an open command.
@@ -28,10 +35,13 @@ func NewCmdOpen() *cobra.Command {
 			"cmd:main":  "true",
 			"help:args": "[ISSUE-KEY]\tIssue key, eg: ISSUE-1",
 		},
-		Run: open,
+		ValidArgsFunction: cmdcommon.IssueKeyCompletion(1),
+		Run:               open,
 	}
 
 	cmd.Flags().BoolP("no-browser", "n", false, `Skip opening destination URL in the browser`)
+	cmd.Flags().Bool("copy", false, `Copy destination URL to the clipboard instead of opening it in the browser`)
+	cmd.Flags().Bool("print-url", false, `Only print destination URL without opening it in the browser`)
 
 	return &cmd
 }
@@ -48,12 +58,23 @@ func open(cmd *cobra.Command, args []string) {
 		url = cmdutil.GenerateServerBrowseURL(server, cmdutil.GetJiraIssueKey(project, args[0]))
 	}
 
-	fmt.Println(url)
+	copyURL, err := cmd.Flags().GetBool("copy")
+	cmdutil.ExitIfError(err)
+
+	if copyURL {
+		cmdutil.CopyToClipboard(url)
+		return
+	}
+
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), url)
+
+	printURL, err := cmd.Flags().GetBool("print-url")
+	cmdutil.ExitIfError(err)
 
 	noBrowser, err := cmd.Flags().GetBool("no-browser")
 	cmdutil.ExitIfError(err)
 
-	if !noBrowser {
+	if !noBrowser && !printURL {
 		cmdutil.ExitIfError(browser.Browse(url))
 	}
 }
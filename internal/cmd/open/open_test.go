@@ -0,0 +1,50 @@
+package open
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenPrintURL(t *testing.T) {
+	viper.Set("server", "https://jira.example.com")
+	viper.Set("project.key", "test")
+	defer viper.Reset()
+
+	cases := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "it prints the issue URL",
+			args:     []string{"TEST-1", "--print-url"},
+			expected: "https://jira.example.com/browse/TEST-1\n",
+		},
+		{
+			name:     "it prefixes a numeric key with the project",
+			args:     []string{"2", "--print-url"},
+			expected: "https://jira.example.com/browse/TEST-2\n",
+		},
+		{
+			name:     "it prints the project URL without a key",
+			args:     []string{"--print-url"},
+			expected: "https://jira.example.com/browse/test\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			cmd := NewCmdOpen()
+			cmd.SetOut(&out)
+			cmd.SetArgs(tc.args)
+
+			assert.NoError(t, cmd.Execute())
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package ping
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Ping checks that the Jira server is reachable and the configured credentials work.
+
+It reports the server version, the authenticated user and the measured latency.
+The command exits with a non-zero exit code and the reason if any of the checks fail,
+making it the first thing to run when nothing seems to work.`
+	examples = `$ jira ping
+
+# Check a different profile
+$ jira ping --config ~/.config/.jira/work.yml`
+)
+
+// NewCmdPing is a ping command.
+func NewCmdPing() *cobra.Command {
+	return &cobra.Command{
+		Use:         "ping",
+		Short:       "Ping checks connectivity and authentication with the Jira server",
+		Long:        helpText,
+		Example:     examples,
+		Aliases:     []string{"health"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		Run:         ping,
+	}
+}
+
+func ping(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+	client := api.DefaultClient(debug)
+
+	start := time.Now()
+	info, err := client.ServerInfo()
+	latency := time.Since(start)
+	if err != nil {
+		fail(fmt.Sprintf("Request to server %s failed: %s", server, reason(err)), err)
+	}
+	pass("Server %s is reachable (%s)", server, latency.Round(time.Millisecond))
+	pass("Server version %s (%s)", info.Version, info.DeploymentType)
+
+	me, err := client.Me()
+	if err != nil {
+		fail(fmt.Sprintf("Authentication as %q failed: %s", viper.GetString("login"), reason(err)), err)
+	}
+	pass("Authenticated as %s", me.Name)
+}
+
+// reason explains why a request failed in terms of the usual setup problems.
+func reason(err error) string {
+	var (
+		dnsErr      *net.DNSError
+		opErr       *net.OpError
+		unknownAuth x509.UnknownAuthorityError
+		hostErr     x509.HostnameError
+		certErr     x509.CertificateInvalidError
+		verifyErr   *tls.CertificateVerificationError
+		recordErr   tls.RecordHeaderError
+	)
+
+	switch {
+	case errors.As(err, &dnsErr):
+		return fmt.Sprintf("DNS lookup of %s failed, check the server URL", dnsErr.Name)
+	case errors.As(err, &unknownAuth), errors.As(err, &hostErr), errors.As(err, &certErr), errors.As(err, &verifyErr):
+		return fmt.Sprintf("TLS certificate verification failed: %s", err)
+	case errors.As(err, &recordErr):
+		return "TLS handshake failed, the server may not be serving HTTPS on this port"
+	case errors.Is(err, jira.ErrUnauthorized):
+		return "invalid credentials, check the login and API token"
+	case errors.Is(err, jira.ErrForbidden):
+		return "the user doesn't have permission to access the Jira API"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "request timed out"
+	case errors.As(err, &opErr):
+		return fmt.Sprintf("connection failed: %s", opErr.Err)
+	}
+	return err.Error()
+}
+
+func pass(msg string, args ...interface{}) {
+	fmt.Printf("\u001B[0;32m✓\u001B[0m %s\n", fmt.Sprintf(msg, args...))
+}
+
+func fail(msg string, err error) {
+	cmdutil.Fail("%s", msg)
+	os.Exit(cmdutil.ExitCode(err))
+}
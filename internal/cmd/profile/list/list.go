@@ -0,0 +1,50 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists configuration profiles",
+		Long:    "List lists configuration profiles, the active profile is marked with an asterisk.",
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+}
+
+// List displays configured profiles.
+func List(*cobra.Command, []string) {
+	profiles := jiraConfig.Profiles(viper.GetViper())
+	if len(profiles) == 0 {
+		cmdutil.Failed("No profiles found in %s", viper.ConfigFileUsed())
+		return
+	}
+
+	active := viper.GetString(jiraConfig.ProfileKey)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+	_, _ = fmt.Fprintln(w, "\tNAME\tSERVER\tLOGIN")
+	for _, p := range profiles {
+		marker := ""
+		if p == active {
+			marker = "*"
+		}
+		key := jiraConfig.ProfilesKey + "." + p
+		_, _ = fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\n",
+			marker, p, viper.GetString(key+".server"), viper.GetString(key+".login"),
+		)
+	}
+	cmdutil.ExitIfError(w.Flush())
+}
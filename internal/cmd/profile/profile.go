@@ -0,0 +1,45 @@
+package profile
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/profile/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/profile/use"
+)
+
+const helpText = `Profile manages named configurations for working with multiple Jira instances.
+
+Profiles are defined under the 'profiles' key in the config file. Each profile can
+override any top level config, eg: server, login, auth_type, installation and project.
+
+  profiles:
+    work:
+      server: https://work.example.com
+      login: me@work.example.com
+      installation: Local
+      auth_type: bearer
+      project:
+        key: WRK
+
+A profile is selected with the '--profile' flag, JIRA_PROFILE env var or the
+default profile set with 'jira profile use', in that order.`
+
+// NewCmdProfile is a profile command.
+func NewCmdProfile() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "profile",
+		Short:       "Profile manages configuration profiles",
+		Long:        helpText,
+		Aliases:     []string{"profiles"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        profiles,
+	}
+
+	cmd.AddCommand(list.NewCmdList(), use.NewCmdUse())
+
+	return &cmd
+}
+
+func profiles(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,39 @@
+package use
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Use sets the default profile used when no profile is passed with the '--profile' flag
+or JIRA_PROFILE env var.`
+	examples = `$ jira profile use work`
+)
+
+// NewCmdUse is a use command.
+func NewCmdUse() *cobra.Command {
+	return &cobra.Command{
+		Use:     "use PROFILE",
+		Short:   "Use sets the default profile",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "PROFILE\tName of the profile, eg: work",
+		},
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return jiraConfig.Profiles(viper.GetViper()), cobra.ShellCompDirectiveNoFileComp
+		},
+		Run: use,
+	}
+}
+
+func use(_ *cobra.Command, args []string) {
+	cmdutil.ExitIfError(jiraConfig.SetDefaultProfile(viper.ConfigFileUsed(), args[0]))
+
+	cmdutil.Success("Switched to profile %q", args[0])
+}
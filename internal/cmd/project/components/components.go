@@ -0,0 +1,67 @@
+package components
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Components lists components in a project.
+
+Component names listed here can be used with the --component flag of the issue create and edit commands.`
+	examples = `$ jira project components
+
+# List components of a project other than the configured one
+$ jira project components PROJ`
+)
+
+// NewCmdComponents is a components command.
+func NewCmdComponents() *cobra.Command {
+	return &cobra.Command{
+		Use:     "components [PROJECT-KEY]",
+		Short:   "Components lists components in a project",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"component", "cmp"},
+		Annotations: map[string]string{
+			"help:args": "PROJECT-KEY\tProject key, defaults to the configured project",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  components,
+	}
+}
+
+func components(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	if len(args) > 0 {
+		project = args[0]
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	components, err := func() ([]*jira.Component, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching components in project %s...", project))
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetComponents(project)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(components) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No components found in project %q", project)
+		return
+	}
+
+	v := view.NewComponent(components)
+
+	cmdutil.ExitIfError(v.Render())
+}
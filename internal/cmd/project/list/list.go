@@ -1,23 +1,41 @@
 package list
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const examples = `$ jira project list
+
+# List projects whose key or name matches "core"
+$ jira project list --query core
+
+# List the second page of 50 projects
+$ jira project list --paginate 50:50`
+
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects",
 		Long:    "List lists Jira projects that a user has access to.",
+		Example: examples,
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmd.Flags().String("query", "", "Filter projects by key or name")
+	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -25,15 +43,39 @@ func List(cmd *cobra.Command, _ []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	q, err := cmd.Flags().GetString("query")
+	cmdutil.ExitIfError(err)
+
+	paginate, err := cmd.Flags().GetString("paginate")
+	cmdutil.ExitIfError(err)
+
+	from, limit, err := query.GetPaginateParams(paginate)
+	cmdutil.ExitIfError(err)
+
 	projects, total, err := func() ([]*jira.Project, int, error) {
 		s := cmdutil.Info("Fetching projects...")
 		defer s.Stop()
 
-		projects, err := api.DefaultClient(debug).Project()
+		client := api.DefaultClient(debug)
+
+		// On-premise installations don't support project search, so
+		// all projects are fetched and paginated locally instead.
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			projects, err := client.Project()
+			if err != nil {
+				return nil, 0, err
+			}
+			projects = filterProjects(projects, q)
+			total := len(projects)
+
+			return projects[min(int(from), total):min(int(from+limit), total)], total, nil
+		}
+
+		res, err := client.GetProjects(int(from), int(limit), q)
 		if err != nil {
 			return nil, 0, err
 		}
-		return projects, len(projects), nil
+		return res.Projects, res.Total, nil
 	}()
 	cmdutil.ExitIfError(err)
 
@@ -46,3 +88,18 @@ func List(cmd *cobra.Command, _ []string) {
 
 	cmdutil.ExitIfError(v.Render())
 }
+
+func filterProjects(projects []*jira.Project, q string) []*jira.Project {
+	if q == "" {
+		return projects
+	}
+
+	q = strings.ToLower(q)
+	out := make([]*jira.Project, 0, len(projects))
+	for _, p := range projects {
+		if strings.Contains(strings.ToLower(p.Key), q) || strings.Contains(strings.ToLower(p.Name), q) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -3,7 +3,9 @@ package project
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/components"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/view"
 )
 
 const helpText = `Project manages Jira projects. See available commands below.`
@@ -19,7 +21,7 @@ func NewCmdProject() *cobra.Command {
 		RunE:        projects,
 	}
 
-	cmd.AddCommand(list.NewCmdList())
+	cmd.AddCommand(list.NewCmdList(), view.NewCmdView(), components.NewCmdComponents())
 
 	return &cmd
 }
@@ -0,0 +1,60 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `View shows details of a project including its issue types, components, versions and roles.`
+	examples = `$ jira project view
+
+# View a project other than the configured one
+$ jira project view PRJ`
+)
+
+// NewCmdView is a project view command.
+func NewCmdView() *cobra.Command {
+	return &cobra.Command{
+		Use:     "view [PROJECT-KEY]",
+		Short:   "View shows details of a project",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"show"},
+		Annotations: map[string]string{
+			"help:args": "[PROJECT-KEY]\tProject key, eg: PRJ. Defaults to the configured project",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  view,
+	}
+}
+
+func view(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := viper.GetString("project.key")
+	if len(args) > 0 {
+		key = strings.ToUpper(args[0])
+	}
+
+	project, err := func() (*jira.Project, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching project %s...", key))
+		defer s.Stop()
+
+		return api.DefaultClient(debug).GetProject(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	v := tuiView.NewProjectDetail(project)
+
+	cmdutil.ExitIfError(v.Render())
+}
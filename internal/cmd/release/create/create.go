@@ -0,0 +1,84 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a new version in a project.`
+	examples = `$ jira release create v1.0.0
+
+# Create a version with description and a planned release date
+$ jira release create v1.0.0 --description "First stable release" --release-date 2024-01-31
+
+# Create a version in a project other than the configured one
+$ jira release create v1.0.0 -pPRJ`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create a version in a project",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the version, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  create,
+	}
+
+	cmd.Flags().String("description", "", "Version description")
+	cmd.Flags().String("release-date", "", "Planned release date in YYYY-MM-DD format")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseFlags(cmd.Flags(), args)
+
+	version, err := func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating version %q in project %s...", params.name, project))
+		defer s.Stop()
+
+		return api.DefaultClient(params.debug).CreateVersion(project, params.name, params.description, params.releaseDate)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Version %q created with id %s", version.Name, version.ID)
+}
+
+func parseFlags(flags query.FlagParser, args []string) *createParams {
+	description, err := flags.GetString("description")
+	cmdutil.ExitIfError(err)
+
+	releaseDate, err := flags.GetString("release-date")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		name:        args[0],
+		description: description,
+		releaseDate: releaseDate,
+		debug:       debug,
+	}
+}
+
+type createParams struct {
+	name        string
+	description string
+	releaseDate string
+	debug       bool
+}
@@ -31,7 +31,7 @@ func List(cmd *cobra.Command, _ []string) {
 		s := cmdutil.Info("Fetching project versions...")
 		defer s.Stop()
 
-		releases, err := api.DefaultClient(debug).Release(project)
+		releases, err := api.DefaultClient(debug).GetVersions(project)
 		if err != nil {
 			return nil, 0, err
 		}
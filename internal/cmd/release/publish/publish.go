@@ -0,0 +1,116 @@
+package publish
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Publish marks a version as released.
+
+The version can be referenced either by its name or its id. Release date defaults to today.`
+	examples = `$ jira release publish v1.0.0
+
+# Release a version with a specific release date
+$ jira release publish 10010 --release-date 2024-02-01
+
+# Mark a released version as unreleased again
+$ jira release publish v1.0.0 --unrelease`
+)
+
+// NewCmdPublish is a publish command.
+func NewCmdPublish() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "publish VERSION",
+		Short:   "Mark a version as released",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"release", "ship"},
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or id of the version, eg: v1.0.0",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  publish,
+	}
+
+	cmd.Flags().String("release-date", "", "Release date in YYYY-MM-DD format, defaults to today")
+	cmd.Flags().Bool("unrelease", false, "Mark the version as unreleased")
+
+	return &cmd
+}
+
+func publish(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	version, err := func() (*jira.ProjectVersion, error) {
+		s := cmdutil.Info("Updating version...")
+		defer s.Stop()
+
+		id, err := resolveVersionID(client, project, params.version)
+		if err != nil {
+			return nil, err
+		}
+		return client.ReleaseVersion(id, !params.unrelease, params.releaseDate)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if version.Released {
+		cmdutil.Success("Version %q marked as released", version.Name)
+	} else {
+		cmdutil.Success("Version %q marked as unreleased", version.Name)
+	}
+}
+
+// resolveVersionID returns the id of a version in the project matching
+// the given name or id.
+func resolveVersionID(client *jira.Client, project, version string) (string, error) {
+	versions, err := client.GetVersions(project)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range versions {
+		if v.ID == version || v.Name == version {
+			return v.ID, nil
+		}
+	}
+	return "", fmt.Errorf("version %q not found in project %s", version, project)
+}
+
+func parseFlags(flags query.FlagParser, args []string) *publishParams {
+	releaseDate, err := flags.GetString("release-date")
+	cmdutil.ExitIfError(err)
+
+	unrelease, err := flags.GetBool("unrelease")
+	cmdutil.ExitIfError(err)
+
+	if releaseDate == "" && !unrelease {
+		releaseDate = time.Now().Format("2006-01-02")
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &publishParams{
+		version:     args[0],
+		releaseDate: releaseDate,
+		unrelease:   unrelease,
+		debug:       debug,
+	}
+}
+
+type publishParams struct {
+	version     string
+	releaseDate string
+	unrelease   bool
+	debug       bool
+}
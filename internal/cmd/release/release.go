@@ -3,7 +3,9 @@ package release
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/create"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/publish"
 )
 
 const helpText = `Release manages Jira Project versions. See available commands below.`
@@ -15,11 +17,11 @@ func NewCmdRelease() *cobra.Command {
 		Short:       "Release manages Jira Project versions",
 		Long:        helpText,
 		Annotations: map[string]string{"cmd:main": "true"},
-		Aliases:     []string{"releases"},
+		Aliases:     []string{"releases", "versions"},
 		RunE:        releases,
 	}
 
-	cmd.AddCommand(list.NewCmdList())
+	cmd.AddCommand(list.NewCmdList(), create.NewCmdCreate(), publish.NewCmdPublish())
 
 	return &cmd
 }
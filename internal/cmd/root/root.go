@@ -5,21 +5,28 @@ import (
 	"os"
 	"slices"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/completion"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/dashboard"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group"
 	initCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/init"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/man"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/me"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/open"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/ping"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/profile"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/project"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/release"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/serverinfo"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/user"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/version"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
@@ -35,8 +42,9 @@ const (
 )
 
 var (
-	config string
-	debug  bool
+	config      string
+	profileName string
+	debug       bool
 )
 
 func init() {
@@ -66,6 +74,21 @@ func init() {
 		if err := viper.ReadInConfig(); err == nil && debug {
 			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
 		}
+
+		// Profile passed as a flag has priority over JIRA_PROFILE env and the config.
+		name := profileName
+		if name == "" {
+			name = viper.GetString(jiraConfig.ProfileKey)
+		}
+		if name != "" {
+			if err := jiraConfig.ApplyProfile(viper.GetViper(), name); err != nil {
+				cmdutil.Failed("Error: %s", err)
+			}
+			viper.Set(jiraConfig.ProfileKey, name)
+			if debug {
+				fmt.Printf("Using profile: %s\n", name)
+			}
+		}
 	})
 }
 
@@ -79,6 +102,11 @@ func NewCmdRoot() *cobra.Command {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+			// NO_COLOR env is respected by the color package itself.
+			if viper.GetBool("no_color") {
+				color.NoColor = true
+			}
+
 			subCmd := cmd.Name()
 			if !cmdRequireToken(subCmd) {
 				return
@@ -112,13 +140,33 @@ func NewCmdRoot() *cobra.Command {
 			configHome, jiraConfig.Dir, jiraConfig.FileName,
 		),
 	)
+	cmd.PersistentFlags().StringVar(
+		&profileName, "profile", "",
+		"Config profile to use (defaults to JIRA_PROFILE env var or the profile set in the config file)",
+	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
+	cmd.PersistentFlags().Bool("dry-run", false, "Print mutating requests instead of sending them")
+	cmd.PersistentFlags().Bool("no-notify", false, "Do not notify watchers about issue edits, transitions and assignments always notify")
+	cmd.PersistentFlags().String(
+		"date-format", "",
+		"Format to display dates in, either \"relative\", eg: 3 days ago, or a Go time layout, eg: \"2006-01-02 15:04\"",
+	)
+	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output, can also be disabled with NO_COLOR env")
+	cmd.PersistentFlags().String(
+		"output", "",
+		"Set to \"json\" to print errors as JSON in stderr, eg: {\"error\":\"...\",\"code\":2,\"details\":{...}}",
+	)
 
 	cmd.SetHelpFunc(helpFunc)
 
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("dry_run", cmd.PersistentFlags().Lookup("dry-run"))
+	_ = viper.BindPFlag("no_notify", cmd.PersistentFlags().Lookup("no-notify"))
+	_ = viper.BindPFlag("date_format", cmd.PersistentFlags().Lookup("date-format"))
+	_ = viper.BindPFlag("no_color", cmd.PersistentFlags().Lookup("no-color"))
+	_ = viper.BindPFlag("output", cmd.PersistentFlags().Lookup("output"))
 
 	addChildCommands(&cmd)
 
@@ -135,10 +183,16 @@ func addChildCommands(cmd *cobra.Command) {
 		project.NewCmdProject(),
 		open.NewCmdOpen(),
 		me.NewCmdMe(),
+		user.NewCmdUser(),
+		group.NewCmdGroup(),
 		serverinfo.NewCmdServerInfo(),
+		ping.NewCmdPing(),
 		completion.NewCmdCompletion(),
 		version.NewCmdVersion(),
 		release.NewCmdRelease(),
+		filter.NewCmdFilter(),
+		dashboard.NewCmdDashboard(),
+		profile.NewCmdProfile(),
 		man.NewCmdMan(),
 	)
 }
@@ -151,6 +205,8 @@ func cmdRequireToken(cmd string) bool {
 		"version",
 		"completion",
 		"man",
+		cobra.ShellCompRequestCmd,
+		cobra.ShellCompNoDescRequestCmd,
 	}
 	return !slices.Contains(allowList, cmd)
 }
@@ -2,6 +2,7 @@ package add
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -11,11 +12,15 @@ import (
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
 	helpText = `Add issues to sprint.`
-	examples = `$ jira sprint add SPRINT_ID ISSUE-1 ISSUE-2`
+	examples = `$ jira sprint add SPRINT_ID ISSUE-1 ISSUE-2
+
+# Issues can also be passed as a comma separated list
+$ jira sprint add SPRINT_ID ISSUE-1,ISSUE-2`
 )
 
 // NewCmdAdd is an add command.
@@ -66,6 +71,9 @@ func add(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Adding issues to the sprint...")
 		defer s.Stop()
 
+		if err := validateIssues(client, params.sprintID, params.issues); err != nil {
+			return err
+		}
 		return client.SprintIssuesAdd(params.sprintID, params.issues...)
 	}()
 	cmdutil.ExitIfError(err)
@@ -73,6 +81,45 @@ func add(cmd *cobra.Command, args []string) {
 	cmdutil.Success(fmt.Sprintf("Issues added to the sprint %s\n%s", params.sprintID, cmdutil.GenerateServerBrowseURL(server, project)))
 }
 
+// validateIssues makes sure that the issues belong to the project of the board
+// the sprint was created in. Boards that are not located in a project are skipped.
+func validateIssues(client *jira.Client, sprintID string, issues []string) error {
+	id, err := strconv.Atoi(sprintID)
+	if err != nil {
+		return fmt.Errorf("invalid sprint id %q", sprintID)
+	}
+
+	sprint, err := client.GetSprint(id)
+	if err != nil {
+		return err
+	}
+	if sprint.BoardID == 0 {
+		return nil
+	}
+
+	board, err := client.GetBoard(sprint.BoardID)
+	if err != nil {
+		return err
+	}
+	if board.Location == nil || board.Location.ProjectKey == "" {
+		return nil
+	}
+
+	var invalid []string
+	for _, iss := range issues {
+		if !strings.HasPrefix(iss, board.Location.ProjectKey+"-") {
+			invalid = append(invalid, iss)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf(
+			"issues %s do not belong to project %s of the sprint board",
+			strings.Join(invalid, ", "), board.Location.ProjectKey,
+		)
+	}
+	return nil
+}
+
 func parseFlags(flags query.FlagParser, args []string, project string) *addParams {
 	var (
 		sprintID string
@@ -86,8 +133,13 @@ func parseFlags(flags query.FlagParser, args []string, project string) *addParam
 	if nArgs > 1 {
 		tickets := args[1:]
 		issues = make([]string, 0, len(tickets))
-		for _, iss := range tickets {
-			issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+		for _, t := range tickets {
+			// Issues can also be passed as a comma separated list, eg: ISSUE-1,ISSUE-2.
+			for _, iss := range strings.Split(t, ",") {
+				if iss = strings.TrimSpace(iss); iss != "" {
+					issues = append(issues, cmdutil.GetJiraIssueKey(project, iss))
+				}
+			}
 		}
 	}
 
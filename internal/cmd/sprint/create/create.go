@@ -0,0 +1,115 @@
+package create
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create a future sprint in a board.
+
+Sprint is created in the configured board by default. Dates are optional,
+but a sprint can only be started once both the start and the end dates are set.`
+	examples = `$ jira sprint create "Sprint 42"
+
+# Create a sprint with dates and a goal
+$ jira sprint create "Sprint 42" --start 2024-01-01 --end 2024-01-14 --goal "Ship the new importer"
+
+# Create a sprint in another board with dates in a specific timezone
+$ jira sprint create "Sprint 42" --board 3 --start "2024-01-01 09:00:00" --end "2024-01-14 17:00:00" --timezone "Europe/Berlin"`
+)
+
+// NewCmdCreate is a create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create a sprint in a board",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the sprint, eg: Sprint 42",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  create,
+	}
+
+	cmd.Flags().Uint("board", 0, "ID of the board to create the sprint in, defaults to the configured board")
+	cmd.Flags().String("start", "", "Start date of the sprint, eg: 2024-01-01 or \"2024-01-01 09:00:00\"")
+	cmd.Flags().String("end", "", "End date of the sprint, eg: 2024-01-14 or \"2024-01-14 17:00:00\"")
+	cmd.Flags().String("goal", "", "Sprint goal")
+	cmd.Flags().String("timezone", "UTC", "The timezone to use for the dates in IANA timezone format, eg: Europe/Berlin")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Creating sprint...")
+		defer s.Stop()
+
+		return client.CreateSprint(params.boardID, params.name, params.startDate, params.endDate, params.goal)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Sprint %q created with id %d", sprint.Name, sprint.ID)
+}
+
+func parseFlags(flags query.FlagParser, args []string) *createParams {
+	board, err := flags.GetUint("board")
+	cmdutil.ExitIfError(err)
+
+	boardID := int(board)
+	if boardID == 0 {
+		boardID = viper.GetInt("board.id")
+	}
+	if boardID == 0 {
+		cmdutil.Failed("Unable to find a board to create the sprint in, use --board to set one")
+	}
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	start, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	startDate, err := cmdutil.DateStringToJiraFormatInLocation(start, timezone)
+	cmdutil.ExitIfError(err)
+
+	end, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	endDate, err := cmdutil.DateStringToJiraFormatInLocation(end, timezone)
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		boardID:   boardID,
+		name:      args[0],
+		startDate: startDate,
+		endDate:   endDate,
+		goal:      goal,
+		debug:     debug,
+	}
+}
+
+type createParams struct {
+	boardID   int
+	name      string
+	startDate string
+	endDate   string
+	goal      string
+	debug     bool
+}
@@ -130,6 +130,9 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 	noTruncate, err := flags.GetBool("no-truncate")
 	cmdutil.ExitIfError(err)
 
+	maxColWidth, err := flags.GetUint("max-col-width")
+	cmdutil.ExitIfError(err)
+
 	fixedColumns, err := flags.GetUint("fixed-columns")
 	cmdutil.ExitIfError(err)
 
@@ -172,6 +175,7 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 			CSV:          csv,
 			NoHeaders:    noHeaders,
 			NoTruncate:   noTruncate,
+			MaxColWidth:  maxColWidth,
 			FixedColumns: fixedColumns,
 			Columns: func() []string {
 				if columns != "" {
@@ -181,6 +185,7 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			DateFormat: viper.GetString("date_format"),
 		},
 	}
 
@@ -218,6 +223,9 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 	fixedColumns, err := flags.GetUint("fixed-columns")
 	cmdutil.ExitIfError(err)
 
+	maxColWidth, err := flags.GetUint("max-col-width")
+	cmdutil.ExitIfError(err)
+
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
@@ -240,6 +248,7 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 		Display: view.DisplayFormat{
 			Plain:        plain,
 			NoHeaders:    noHeaders,
+			MaxColWidth:  maxColWidth,
 			FixedColumns: fixedColumns,
 			Columns: func() []string {
 				if columns != "" {
@@ -249,6 +258,7 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			DateFormat: viper.GetString("date_format"),
 		},
 	}
 
@@ -291,6 +301,7 @@ func setFlags(cmd *cobra.Command) {
 func hideFlags(cmd *cobra.Command) {
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("history"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("watching"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("mine"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("type"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("resolution"))
 	cmdutil.ExitIfError(cmd.Flags().MarkHidden("status"))
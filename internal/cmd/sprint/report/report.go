@@ -0,0 +1,144 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Report shows a quick summary of a sprint with issue counts by status.
+
+Points are summed from the field set with --points-field or the
+"sprint.points_field" config. The field can either be a field id, eg:
+customfield_10016, or the name of a custom field configured in the config file.`
+	examples = `$ jira sprint report SPRINT_ID
+
+# Sum story points stored in a custom field
+$ jira sprint report SPRINT_ID --points-field customfield_10016
+$ jira sprint report SPRINT_ID --points-field "Story Points"
+
+# Narrow down issues with an additional JQL filter
+$ jira sprint report SPRINT_ID -q"type = Bug"`
+)
+
+// NewCmdReport is a report command.
+func NewCmdReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report SPRINT_ID",
+		Short:   "Summarize issues and points in a sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"summary"},
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint to summarize, eg: 123\n",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  report,
+	}
+
+	cmd.Flags().String("points-field", "", "Field to sum points from, eg: customfield_10016")
+	cmd.Flags().StringP("jql", "q", "", "Additional JQL filter for sprint issues")
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug)
+
+	issues, points, err := func() ([]*jira.Issue, map[string]float64, error) {
+		s := cmdutil.Info("Fetching sprint issues...")
+		defer s.Stop()
+
+		res, err := client.GetSprintIssues(params.sprintID, params.jql)
+		if err != nil {
+			return nil, nil, err
+		}
+		if params.pointsField == "" {
+			return res.Issues, nil, nil
+		}
+		points, err := client.SprintIssuePoints(params.sprintID, params.pointsField)
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Issues, points, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No issues found in sprint %d", params.sprintID)
+		return
+	}
+
+	var opts []view.SprintReportOption
+	if points != nil {
+		opts = append(opts, view.WithSprintReportPoints(points))
+	}
+
+	cmdutil.ExitIfError(view.NewSprintReport(issues, opts...).Render())
+}
+
+// resolvePointsField returns the field id for the given field. Names are
+// looked up in custom fields configured in the config file.
+func resolvePointsField(field string) string {
+	if field == "" || strings.HasPrefix(field, "customfield_") {
+		return field
+	}
+
+	configured, err := cmdcommon.GetConfiguredCustomFields()
+	if err != nil {
+		return field
+	}
+	for _, f := range configured {
+		if strings.EqualFold(f.Name, field) {
+			return f.Key
+		}
+	}
+	return field
+}
+
+func parseFlags(flags query.FlagParser, args []string) *reportParams {
+	sprintID, err := strconv.Atoi(args[0])
+	if err != nil {
+		cmdutil.Failed("Invalid sprint id %q", args[0])
+	}
+
+	pointsField, err := flags.GetString("points-field")
+	cmdutil.ExitIfError(err)
+
+	if pointsField == "" {
+		pointsField = viper.GetString("sprint.points_field")
+	}
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &reportParams{
+		sprintID:    sprintID,
+		pointsField: resolvePointsField(pointsField),
+		jql:         jql,
+		debug:       debug,
+	}
+}
+
+type reportParams struct {
+	sprintID    int
+	pointsField string
+	jql         string
+	debug       bool
+}
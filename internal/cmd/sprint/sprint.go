@@ -5,7 +5,10 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/add"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/close"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/create"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/report"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/start"
 )
 
 const helpText = `Sprint manage sprints in a project board. See available commands below.`
@@ -25,7 +28,7 @@ func NewCmdSprint() *cobra.Command {
 	ac := add.NewCmdAdd()
 	cc := close.NewCmdClose()
 
-	cmd.AddCommand(lc, ac, cc)
+	cmd.AddCommand(lc, ac, cc, create.NewCmdCreate(), start.NewCmdStart(), report.NewCmdReport())
 
 	list.SetFlags(lc)
 
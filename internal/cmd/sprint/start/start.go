@@ -0,0 +1,55 @@
+package start
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Start a future sprint.
+
+The sprint must have both start and end dates, and there must not be
+any other active sprint in the board.`
+	examples = `$ jira sprint start SPRINT_ID`
+)
+
+// NewCmdStart is a start command.
+func NewCmdStart() *cobra.Command {
+	return &cobra.Command{
+		Use:     "start SPRINT_ID",
+		Short:   "Start sprint",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint to start, eg: 123\n",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  startSprint,
+	}
+}
+
+func startSprint(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	sprintID, err := strconv.Atoi(args[0])
+	if err != nil {
+		cmdutil.Failed("Invalid sprint id %q", args[0])
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Starting sprint...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).UpdateSprintState(sprintID, jira.SprintStateActive)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %d has been started.", sprintID))
+}
@@ -0,0 +1,69 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Search looks up users by name, username or email.
+
+The ID displayed in the output is the account ID for cloud installations and the
+username for local installations. Use it when assigning issues or adding watchers.`
+	examples = `$ jira user search alice
+
+# Fetch up to 200 matching users
+$ jira user search "Jane Doe" --limit 200`
+)
+
+// NewCmdSearch is a search command.
+func NewCmdSearch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "search QUERY",
+		Short:   "Search looks up users by name, username or email",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"find"},
+		Annotations: map[string]string{
+			"help:args": "QUERY\tName, username or email of the user to look for",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  search,
+	}
+
+	cmd.Flags().Int("limit", 50, "Maximum number of users to fetch")
+
+	return &cmd
+}
+
+func search(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetInt("limit")
+	cmdutil.ExitIfError(err)
+
+	users, err := func() ([]*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Searching users matching %q...", args[0]))
+		defer s.Stop()
+
+		return api.ProxySearchUsers(api.DefaultClient(debug), args[0], limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(users) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No users found matching %q", args[0])
+		return
+	}
+
+	v := view.NewUser(users)
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,151 @@
+package cmdcommon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+)
+
+const (
+	issueCacheDir  = "jira-cli"
+	issueCacheFile = "issues.json"
+	issueCacheTTL  = 5 * time.Minute
+	issueCacheSize = 50
+)
+
+type issueCacheEntry struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+}
+
+type issueCache struct {
+	Server  string            `json:"server"`
+	Project string            `json:"project"`
+	Updated time.Time         `json:"updated"`
+	Issues  []issueCacheEntry `json:"issues"`
+}
+
+// IssueKeyCompletion returns a completion function that suggests keys of issues assigned
+// to or recently viewed by the user for the first n positional args. Issues are cached
+// for a few minutes so that repeated completions don't hit the server every time.
+func IssueKeyCompletion(n int) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= n {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		issues, err := myIssues()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		out := make([]string, 0, len(issues))
+		for _, iss := range issues {
+			if !strings.HasPrefix(strings.ToUpper(iss.Key), strings.ToUpper(toComplete)) {
+				continue
+			}
+			out = append(out, fmt.Sprintf("%s\t%s", iss.Key, iss.Summary))
+		}
+		return out, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// IssueTypeCompletion suggests issue type names configured for the project.
+func IssueTypeCompletion(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	types, ok := viper.Get("issue.types").([]interface{})
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	out := make([]string, 0, len(types))
+	for _, t := range types {
+		tp, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := tp["name"].(string)
+		if name == "" || !strings.HasPrefix(strings.ToLower(name), strings.ToLower(toComplete)) {
+			continue
+		}
+		out = append(out, name)
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
+func myIssues() ([]issueCacheEntry, error) {
+	server := viper.GetString("server")
+	project := viper.GetString("project.key")
+
+	path, err := issueCachePath()
+	if err != nil {
+		return nil, err
+	}
+	if cache, err := readIssueCache(path); err == nil &&
+		cache.Server == server && cache.Project == project && time.Since(cache.Updated) < issueCacheTTL {
+		return cache.Issues, nil
+	}
+
+	jql := "(assignee = currentUser() OR issue in issueHistory()) ORDER BY updated DESC"
+	if project != "" {
+		jql = fmt.Sprintf("project = %q AND %s", project, jql)
+	}
+	res, err := api.ProxySearch(api.DefaultClient(false), jql, 0, issueCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := issueCache{
+		Server:  server,
+		Project: project,
+		Updated: time.Now(),
+		Issues:  make([]issueCacheEntry, 0, len(res.Issues)),
+	}
+	for _, iss := range res.Issues {
+		cache.Issues = append(cache.Issues, issueCacheEntry{Key: iss.Key, Summary: iss.Fields.Summary})
+	}
+
+	// Failing to write the cache shouldn't prevent completion.
+	_ = writeIssueCache(path, &cache)
+
+	return cache.Issues, nil
+}
+
+func issueCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, issueCacheDir, issueCacheFile), nil
+}
+
+func readIssueCache(path string) (*issueCache, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cache issueCache
+	if err := json.Unmarshal(b, &cache); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+func writeIssueCache(path string, cache *issueCache) error {
+	b, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o600)
+}
@@ -1,6 +1,7 @@
 package cmdcommon
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -36,8 +37,10 @@ type CreateParams struct {
 	FixVersions      []string
 	AffectsVersions  []string
 	OriginalEstimate string
+	SecurityLevel    string
 	CustomFields     map[string]string
 	Template         string
+	Editor           bool
 	NoInput          bool
 	Debug            bool
 }
@@ -52,11 +55,12 @@ func SetCreateFlags(cmd *cobra.Command, prefix string) {
 		cmd.Flags().StringP("name", "n", "", "Epic name")
 	} else {
 		cmd.Flags().StringP("type", "t", "", "Issue type")
+		_ = cmd.RegisterFlagCompletionFunc("type", IssueTypeCompletion)
 		cmd.Flags().StringP("parent", "P", "", `Parent issue key can be used to attach epic to an issue.
 And, this field is mandatory when creating a sub-task.`)
 	}
 	cmd.Flags().StringP("summary", "s", "", prefix+" summary or title")
-	cmd.Flags().StringP("body", "b", "", prefix+" description")
+	cmd.Flags().StringP("body", "b", "", prefix+" description, use - to read it from stdin or @path to read it from a file")
 	cmd.Flags().StringP("priority", "y", "", prefix+" priority")
 	cmd.Flags().StringP("reporter", "r", "", prefix+" reporter (username, email or display name)")
 	cmd.Flags().StringP("assignee", "a", "", prefix+" assignee (username, email or display name)")
@@ -65,8 +69,10 @@ And, this field is mandatory when creating a sub-task.`)
 	cmd.Flags().StringArray("fix-version", []string{}, "Release info (fixVersions)")
 	cmd.Flags().StringArray("affects-version", []string{}, "Release info (affectsVersions)")
 	cmd.Flags().StringP("original-estimate", "e", "", prefix+" Original estimate")
+	cmd.Flags().String("security", "", prefix+" security level name or id")
 	cmd.Flags().StringToString("custom", custom, "Set custom fields")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read body/description from")
+	cmd.Flags().Bool("editor", false, "Write description in $EDITOR, template is used as the initial content")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful creation")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 }
@@ -218,6 +224,35 @@ func GetRelevantUser(client *jira.Client, project string, user string) string {
 	return GetUserKeyForConfiguredInstallation(u[0])
 }
 
+// GetSecurityLevelID resolves the security level name to its id. Valid levels are
+// listed in the error message if the level can't be found in the project.
+func GetSecurityLevelID(client *jira.Client, project string, level string) string {
+	if level == "" {
+		return ""
+	}
+	levels, err := client.GetSecurityLevels(project)
+	if err != nil {
+		cmdutil.Failed("Unable to fetch security levels of project %s: %s", project, err)
+	}
+	if l := jira.FindSecurityLevel(levels, level); l != nil {
+		return l.ID
+	}
+
+	names := make([]string, 0, len(levels))
+	for _, l := range levels {
+		names = append(names, l.Name)
+	}
+	if len(names) == 0 {
+		cmdutil.ExitIfError(&cmdutil.ValidationError{
+			Msg: fmt.Sprintf("invalid security level %q, no security levels are available in project %s", level, project),
+		})
+	}
+	cmdutil.ExitIfError(&cmdutil.ValidationError{
+		Msg: fmt.Sprintf("invalid security level %q, available levels: %s", level, strings.Join(names, ", ")),
+	})
+	return ""
+}
+
 // GetUserKeyForConfiguredInstallation returns either the user name or account ID based on jira installation type.
 func GetUserKeyForConfiguredInstallation(user *jira.User) string {
 	it := viper.GetString("installation")
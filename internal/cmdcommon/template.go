@@ -0,0 +1,227 @@
+package cmdcommon
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// IssueTemplate is an issue definition read from a YAML or JSON file, eg: a bug report
+// template checked into a repository. String values may contain ${VAR} placeholders.
+type IssueTemplate struct {
+	Project          string            `yaml:"project"`
+	Type             string            `yaml:"type"`
+	Parent           string            `yaml:"parent"`
+	Summary          string            `yaml:"summary"`
+	Body             string            `yaml:"body"`
+	Priority         string            `yaml:"priority"`
+	Reporter         string            `yaml:"reporter"`
+	Assignee         string            `yaml:"assignee"`
+	Labels           []string          `yaml:"labels"`
+	Components       []string          `yaml:"components"`
+	FixVersions      []string          `yaml:"fixVersions"`
+	AffectsVersions  []string          `yaml:"affectsVersions"`
+	OriginalEstimate string            `yaml:"originalEstimate"`
+	Security         string            `yaml:"security"`
+	Custom           map[string]string `yaml:"custom"`
+}
+
+// ReadIssueTemplate reads an issue template from the file, use - to read it from stdin.
+// JSON is parsed as well since it is a subset of YAML. Placeholders are replaced with
+// values from vars or the environment.
+func ReadIssueTemplate(path string, vars map[string]string) (*IssueTemplate, error) {
+	b, err := cmdutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tpl IssueTemplate
+
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&tpl); err != nil && !errors.Is(err, io.EOF) {
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("invalid issue template %s: %s", path, err)}
+	}
+	if err := tpl.expand(vars); err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+func (t *IssueTemplate) expand(vars map[string]string) error {
+	var err error
+
+	str := func(s *string) {
+		if err == nil {
+			*s, err = cmdutil.ExpandVars(*s, vars)
+		}
+	}
+	list := func(l []string) {
+		for i := range l {
+			str(&l[i])
+		}
+	}
+
+	for _, s := range []*string{
+		&t.Project, &t.Type, &t.Parent, &t.Summary, &t.Body, &t.Priority, &t.Reporter,
+		&t.Assignee, &t.OriginalEstimate, &t.Security,
+	} {
+		str(s)
+	}
+	list(t.Labels)
+	list(t.Components)
+	list(t.FixVersions)
+	list(t.AffectsVersions)
+	for k, v := range t.Custom {
+		str(&v)
+		t.Custom[k] = v
+	}
+
+	return err
+}
+
+// Apply copies values from the template to the params. Values already set, ie: passed
+// with flags, take precedence. List values from flags are appended to the template ones.
+func (t *IssueTemplate) Apply(params *CreateParams) {
+	set := func(dst *string, src string) {
+		if *dst == "" {
+			*dst = src
+		}
+	}
+
+	set(&params.IssueType, t.Type)
+	set(&params.ParentIssueKey, t.Parent)
+	set(&params.Summary, t.Summary)
+	set(&params.Body, t.Body)
+	set(&params.Priority, t.Priority)
+	set(&params.Reporter, t.Reporter)
+	set(&params.Assignee, t.Assignee)
+	set(&params.OriginalEstimate, t.OriginalEstimate)
+	set(&params.SecurityLevel, t.Security)
+
+	params.Labels = append(t.Labels, params.Labels...)
+	params.Components = append(t.Components, params.Components...)
+	params.FixVersions = append(t.FixVersions, params.FixVersions...)
+	params.AffectsVersions = append(t.AffectsVersions, params.AffectsVersions...)
+
+	if len(t.Custom) > 0 {
+		custom := make(map[string]string, len(t.Custom)+len(params.CustomFields))
+		for k, v := range t.Custom {
+			custom[k] = v
+		}
+		for k, v := range params.CustomFields {
+			custom[k] = v
+		}
+		params.CustomFields = custom
+	}
+}
+
+// ReadIssueTemplatesCSV reads one issue template per row from a CSV file, use - to read it
+// from stdin. The header row names the columns the same as the keys of a YAML template, eg:
+// type, summary, labels. List values are comma separated and custom fields use a column
+// named custom.<field>, eg: custom.story-points.
+func ReadIssueTemplatesCSV(path string, vars map[string]string) ([]*IssueTemplate, error) {
+	b, err := cmdutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("invalid CSV file %s: %s", path, err)}
+	}
+	if len(rows) < 2 {
+		return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("CSV file %s has no issues, the first row must be the header", path)}
+	}
+
+	header := rows[0]
+	for _, col := range header {
+		if _, ok := csvColumn(&IssueTemplate{}, col); !ok {
+			return nil, &cmdutil.ValidationError{Msg: fmt.Sprintf("unknown column %q in CSV file %s", col, path)}
+		}
+	}
+
+	tpls := make([]*IssueTemplate, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		var tpl IssueTemplate
+
+		for i, col := range header {
+			set, _ := csvColumn(&tpl, col)
+			set(strings.TrimSpace(row[i]))
+		}
+		if err := tpl.expand(vars); err != nil {
+			return nil, err
+		}
+		tpls = append(tpls, &tpl)
+	}
+
+	return tpls, nil
+}
+
+// csvColumn returns a setter for the template field the column maps to.
+func csvColumn(t *IssueTemplate, col string) (func(string), bool) {
+	str := func(dst *string) func(string) {
+		return func(v string) { *dst = v }
+	}
+	list := func(dst *[]string) func(string) {
+		return func(v string) {
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); s != "" {
+					*dst = append(*dst, s)
+				}
+			}
+		}
+	}
+
+	col = strings.TrimSpace(col)
+	if name, ok := strings.CutPrefix(col, "custom."); ok && name != "" {
+		return func(v string) {
+			if v == "" {
+				return
+			}
+			if t.Custom == nil {
+				t.Custom = make(map[string]string)
+			}
+			t.Custom[name] = v
+		}, true
+	}
+
+	switch strings.ToLower(col) {
+	case "project":
+		return str(&t.Project), true
+	case "type":
+		return str(&t.Type), true
+	case "parent":
+		return str(&t.Parent), true
+	case "summary":
+		return str(&t.Summary), true
+	case "body", "description":
+		return str(&t.Body), true
+	case "priority":
+		return str(&t.Priority), true
+	case "reporter":
+		return str(&t.Reporter), true
+	case "assignee":
+		return str(&t.Assignee), true
+	case "labels":
+		return list(&t.Labels), true
+	case "components":
+		return list(&t.Components), true
+	case "fixversions":
+		return list(&t.FixVersions), true
+	case "affectsversions":
+		return list(&t.AffectsVersions), true
+	case "originalestimate":
+		return str(&t.OriginalEstimate), true
+	case "security":
+		return str(&t.Security), true
+	}
+	return nil, false
+}
@@ -0,0 +1,88 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// OutputJSON is the output format that prints errors as JSON, see --output.
+const OutputJSON = "json"
+
+// jsonError is the shape of errors printed in JSON output mode.
+type jsonError struct {
+	Error   string                 `json:"error"`
+	Code    int                    `json:"code"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// isJSONOutput reports whether errors should be printed as JSON.
+func isJSONOutput() bool {
+	return strings.EqualFold(viper.GetString("output"), OutputJSON)
+}
+
+// writeJSONError writes the error as a single line of JSON along with the exit code.
+func writeJSONError(w io.Writer, err error) {
+	out := jsonError{
+		Error: err.Error(),
+		Code:  ExitCode(err),
+	}
+
+	var (
+		ue *jira.ErrUnexpectedResponse
+		mf *jira.ErrMultipleFailed
+	)
+	switch {
+	case errors.As(err, &ue):
+		out.Error = unexpectedResponseMessage(ue)
+		out.Details = map[string]interface{}{
+			"status": ue.StatusCode,
+		}
+		if ue.Method != "" {
+			out.Details["method"] = ue.Method
+			out.Details["path"] = ue.Path
+		}
+		if len(ue.Messages) > 0 {
+			out.Details["messages"] = ue.Messages
+		}
+		if len(ue.FieldErrors) > 0 {
+			out.Details["fields"] = ue.FieldErrors
+		}
+	case errors.As(err, &mf):
+		failed := make([]string, 0)
+		for _, line := range strings.Split(mf.Msg, "\n") {
+			if line = strings.TrimPrefix(strings.TrimSpace(line), "- "); line != "" {
+				failed = append(failed, line)
+			}
+		}
+		out.Error = "some requests reported error"
+		out.Details = map[string]interface{}{
+			"failed": failed,
+		}
+	}
+
+	b, _ := json.Marshal(out)
+	_, _ = fmt.Fprintf(w, "%s\n", b)
+}
+
+// unexpectedResponseMessage returns a single line summary of the response error.
+func unexpectedResponseMessage(e *jira.ErrUnexpectedResponse) string {
+	msgs := make([]string, 0, len(e.Messages)+len(e.FieldErrors))
+	msgs = append(msgs, e.Messages...)
+	for k, v := range e.FieldErrors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", k, v))
+	}
+	if len(msgs) == 0 && e.Raw != "" {
+		msgs = append(msgs, e.Raw)
+	}
+	if len(msgs) == 0 {
+		return fmt.Sprintf("unexpected response %s", e.Status)
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,66 @@
+package cmdutil
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWriteJSONError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "generic",
+			err:      errors.New("oops"),
+			expected: `{"error":"oops","code":1}`,
+		},
+		{
+			name:     "validation",
+			err:      ValidateIssueKeys("bad key"),
+			expected: `{"error":"invalid issue key: bad key","code":4}`,
+		},
+		{
+			name: "unexpected response",
+			err: &jira.ErrUnexpectedResponse{
+				Status:      "400 Bad Request",
+				StatusCode:  http.StatusBadRequest,
+				Method:      "POST",
+				Path:        "/rest/api/3/issue",
+				Messages:    []string{"Invalid request"},
+				FieldErrors: map[string]string{"summary": "Summary is required"},
+			},
+			expected: `{"error":"Invalid request; summary: Summary is required","code":4,"details":{"fields":{"summary":"Summary is required"},"messages":["Invalid request"],"method":"POST","path":"/rest/api/3/issue","status":400}}`,
+		},
+		{
+			name:     "unexpected response without body",
+			err:      &jira.ErrUnexpectedResponse{Status: "404 Not Found", StatusCode: http.StatusNotFound},
+			expected: `{"error":"unexpected response 404 Not Found","code":2,"details":{"status":404}}`,
+		},
+		{
+			name:     "multiple failed",
+			err:      &jira.ErrMultipleFailed{Msg: "\n  - TEST-1: not found\n  - TEST-2: forbidden"},
+			expected: `{"error":"some requests reported error","code":1,"details":{"failed":["TEST-1: not found","TEST-2: forbidden"]}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out strings.Builder
+			writeJSONError(&out, tc.err)
+
+			assert.Equal(t, tc.expected+"\n", out.String())
+		})
+	}
+}
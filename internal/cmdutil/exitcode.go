@@ -0,0 +1,47 @@
+package cmdutil
+
+import (
+	"errors"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Exit codes of the process so that scripts can branch on why a command failed.
+const (
+	// ExitOK denotes success. Dry runs also exit with it.
+	ExitOK = 0
+	// ExitError denotes a generic failure.
+	ExitError = 1
+	// ExitNotFound denotes that the issue or another resource wasn't found.
+	ExitNotFound = 2
+	// ExitPermission denotes that the request wasn't authenticated or the user isn't allowed to perform it.
+	ExitPermission = 3
+	// ExitValidation denotes invalid arguments, flags or a request rejected by the server as invalid.
+	ExitValidation = 4
+)
+
+// ValidationError denotes invalid user input detected before sending any request.
+type ValidationError struct {
+	Msg string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Msg
+}
+
+// ExitCode returns the process exit code for the given error.
+func ExitCode(err error) int {
+	var ve *ValidationError
+
+	switch {
+	case err == nil, errors.Is(err, jira.ErrDryRun):
+		return ExitOK
+	case errors.Is(err, jira.ErrNotFound), errors.Is(err, jira.ErrFilterNotFound):
+		return ExitNotFound
+	case errors.Is(err, jira.ErrUnauthorized), errors.Is(err, jira.ErrForbidden):
+		return ExitPermission
+	case errors.Is(err, jira.ErrBadRequest), errors.As(err, &ve):
+		return ExitValidation
+	}
+	return ExitError
+}
@@ -0,0 +1,42 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestExitCode(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "no error", err: nil, expected: ExitOK},
+		{name: "dry run", err: jira.ErrDryRun, expected: ExitOK},
+		{name: "generic", err: errors.New("oops"), expected: ExitError},
+		{name: "server error", err: &jira.ErrUnexpectedResponse{StatusCode: http.StatusInternalServerError}, expected: ExitError},
+		{name: "multiple failed", err: &jira.ErrMultipleFailed{Msg: "failed"}, expected: ExitError},
+		{name: "not found", err: &jira.ErrUnexpectedResponse{StatusCode: http.StatusNotFound}, expected: ExitNotFound},
+		{name: "filter not found", err: fmt.Errorf("load: %w", jira.ErrFilterNotFound), expected: ExitNotFound},
+		{name: "unauthorized", err: &jira.ErrUnexpectedResponse{StatusCode: http.StatusUnauthorized}, expected: ExitPermission},
+		{name: "forbidden", err: &jira.ErrUnexpectedResponse{StatusCode: http.StatusForbidden}, expected: ExitPermission},
+		{name: "bad request", err: &jira.ErrUnexpectedResponse{StatusCode: http.StatusBadRequest}, expected: ExitValidation},
+		{name: "invalid key", err: ValidateIssueKeys("TEST-1", "bad key"), expected: ExitValidation},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, ExitCode(tc.err))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatJSON formats raw JSON either indented or compact. If path is set, only the
+// value at the given jq-like path is returned, eg: .fields.status.name. Array items
+// are accessed by index, eg: .fields.comment.comments[0].body or comments.0.body.
+func FormatJSON(raw string, compact bool, path string) (string, error) {
+	data := []byte(raw)
+
+	if path = strings.TrimSpace(path); path != "" && path != "." {
+		dec := json.NewDecoder(strings.NewReader(raw))
+		dec.UseNumber()
+
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return "", err
+		}
+
+		v, err := jsonPathValue(v, path)
+		if err != nil {
+			return "", err
+		}
+		if data, err = json.Marshal(v); err != nil {
+			return "", err
+		}
+	}
+
+	var out bytes.Buffer
+	if compact {
+		if err := json.Compact(&out, data); err != nil {
+			return "", err
+		}
+	} else {
+		if err := json.Indent(&out, data, "", "  "); err != nil {
+			return "", err
+		}
+	}
+	return out.String(), nil
+}
+
+func jsonPathValue(v interface{}, path string) (interface{}, error) {
+	segments := strings.Split(strings.NewReplacer("[", ".", "]", "").Replace(path), ".")
+
+	for _, seg := range segments {
+		if seg == "" {
+			continue
+		}
+		switch node := v.(type) {
+		case map[string]interface{}:
+			val, ok := node[seg]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found in path %q", seg, path)
+			}
+			v = val
+		case []interface{}:
+			idx, err := strconv.Atoi(seg)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, fmt.Errorf("invalid index %q in path %q", seg, path)
+			}
+			v = node[idx]
+		default:
+			return nil, fmt.Errorf("cannot access %q of a non-object value in path %q", seg, path)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,76 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatJSON(t *testing.T) {
+	raw := `{"key":"TEST-1","fields":{"status":{"name":"Done"},"comment":{"comments":[{"body":"first"},{"body":"second"}]},"timespent":3600}}`
+
+	cases := []struct {
+		name     string
+		compact  bool
+		path     string
+		expected string
+		err      string
+	}{
+		{
+			name:     "pretty",
+			expected: "{\n  \"key\": \"TEST-1\",\n  \"fields\": {\n    \"status\": {\n      \"name\": \"Done\"\n    },\n    \"comment\": {\n      \"comments\": [\n        {\n          \"body\": \"first\"\n        },\n        {\n          \"body\": \"second\"\n        }\n      ]\n    },\n    \"timespent\": 3600\n  }\n}",
+		},
+		{
+			name:     "compact",
+			compact:  true,
+			expected: raw,
+		},
+		{
+			name:     "path",
+			compact:  true,
+			path:     ".fields.status",
+			expected: `{"name":"Done"}`,
+		},
+		{
+			name:     "path with array index",
+			path:     ".fields.comment.comments[1].body",
+			expected: `"second"`,
+		},
+		{
+			name:     "path with dotted array index",
+			path:     "fields.comment.comments.0.body",
+			expected: `"first"`,
+		},
+		{
+			name:     "path keeps numbers as is",
+			path:     ".fields.timespent",
+			expected: `3600`,
+		},
+		{
+			name: "missing key",
+			path: ".fields.priority",
+			err:  `key "priority" not found in path ".fields.priority"`,
+		},
+		{
+			name: "invalid index",
+			path: ".fields.comment.comments[5]",
+			err:  `invalid index "5" in path ".fields.comment.comments[5]"`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := FormatJSON(raw, tc.compact, tc.path)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
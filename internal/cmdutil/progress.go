@@ -0,0 +1,61 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"golang.org/x/term"
+)
+
+// Progress displays a spinner along with the number of processed items of a long
+// running operation. Nothing is written if stderr is not a terminal so that no
+// control codes end up in piped or redirected output.
+type Progress struct {
+	s   *spinner.Spinner
+	msg string
+}
+
+// NewProgress starts a progress indicator for an operation on total items.
+func NewProgress(msg string, total int) *Progress {
+	const refreshRate = 100 * time.Millisecond
+
+	p := Progress{msg: msg}
+	if !term.IsTerminal(int(os.Stderr.Fd())) {
+		return &p
+	}
+
+	p.s = spinner.New(
+		spinner.CharSets[14],
+		refreshRate,
+		spinner.WithSuffix(progressSuffix(msg, 0, total)),
+		spinner.WithHiddenCursor(true),
+		spinner.WithWriterFile(os.Stderr),
+	)
+	p.s.Start()
+
+	return &p
+}
+
+// Update sets the number of processed items. It is safe for concurrent
+// use and can be passed to jira.WithProgress as is.
+func (p *Progress) Update(done, total int) {
+	if p.s == nil {
+		return
+	}
+	p.s.Lock()
+	p.s.Suffix = progressSuffix(p.msg, done, total)
+	p.s.Unlock()
+}
+
+// Stop stops and clears the progress indicator.
+func (p *Progress) Stop() {
+	if p.s != nil {
+		p.s.Stop()
+	}
+}
+
+func progressSuffix(msg string, done, total int) string {
+	return fmt.Sprintf(" %s (%d/%d)", msg, done, total)
+}
@@ -0,0 +1,18 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress(t *testing.T) {
+	assert.Equal(t, " Ranking issues... (3/10)", progressSuffix("Ranking issues...", 3, 10))
+
+	// Stderr is not a terminal when running tests, so nothing is displayed.
+	p := NewProgress("Ranking issues...", 10)
+	assert.Nil(t, p.s)
+
+	p.Update(3, 10)
+	p.Stop()
+}
@@ -1,13 +1,17 @@
 package cmdutil
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
-	"strconv"
+	"os/signal"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/atotto/clipboard"
 	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
 	"github.com/mitchellh/go-homedir"
@@ -24,6 +28,13 @@ func ExitIfError(err error) {
 	if err == nil {
 		return
 	}
+	if errors.Is(err, jira.ErrDryRun) {
+		os.Exit(0)
+	}
+	if isJSONOutput() {
+		writeJSONError(os.Stderr, err)
+		os.Exit(ExitCode(err))
+	}
 
 	var msg string
 
@@ -32,6 +43,20 @@ func ExitIfError(err error) {
 			"\njira: Received unexpected response '%s'.\nPlease check the parameters you supplied and try again.",
 			e.Status,
 		)
+		if e.Path != "" {
+			dm = fmt.Sprintf(
+				"\njira: Received unexpected response '%s' for %s %s.\nPlease check the parameters you supplied and try again.",
+				e.Status, e.Method, e.Path,
+			)
+		}
+		switch {
+		case errors.Is(e, jira.ErrUnauthorized):
+			dm += "\nThe request was not authenticated, please check your credentials."
+		case errors.Is(e, jira.ErrForbidden):
+			dm += "\nYou don't have permission to perform this action."
+		case errors.Is(e, jira.ErrNotFound):
+			dm += "\nThe requested resource was not found, please check the issue key or id."
+		}
 		bd := e.Error()
 
 		msg = dm
@@ -50,7 +75,7 @@ func ExitIfError(err error) {
 	}
 
 	fmt.Fprintf(os.Stderr, "%s\n", msg)
-	os.Exit(1)
+	os.Exit(ExitCode(err))
 }
 
 // Info displays spinner.
@@ -84,10 +109,14 @@ func Fail(msg string, args ...interface{}) {
 	_, _ = fmt.Fprintf(os.Stderr, fmt.Sprintf("\u001B[0;31m✗\u001B[0m %s\n", msg), args...)
 }
 
-// Failed prints failure message in stderr and exits.
+// Failed prints failure message in stderr and exits with ExitError.
 func Failed(msg string, args ...interface{}) {
+	if isJSONOutput() {
+		writeJSONError(os.Stderr, fmt.Errorf(msg, args...))
+		os.Exit(ExitError)
+	}
 	Fail(msg, args...)
-	os.Exit(1)
+	os.Exit(ExitError)
 }
 
 // Navigate navigates to jira issue.
@@ -96,14 +125,40 @@ func Navigate(server, path string) error {
 	return browser.Browse(url)
 }
 
+// CopyToClipboard copies text to the system clipboard. If no clipboard utility
+// (xclip, xsel, wl-copy, pbcopy or clip) is available, the text is printed instead
+// so that it can still be copied manually.
+func CopyToClipboard(text string) {
+	if clipboard.Unsupported {
+		fmt.Println(text)
+		return
+	}
+	if err := clipboard.WriteAll(text); err != nil {
+		Warn("Unable to copy to clipboard: %s", err)
+		fmt.Println(text)
+		return
+	}
+	Success("Copied %s to clipboard", text)
+}
+
 // GenerateServerBrowseURL will return the `browse` URL for a given key.
 // The server section can be overridden via `browse_server` in config.
 // This is useful if your API endpoint is separate from the web client endpoint.
 func GenerateServerBrowseURL(server, key string) string {
+	return fmt.Sprintf("%s/browse/%s", browseServer(server), key)
+}
+
+// GenerateServerDashboardURL will return the URL of a dashboard with the given id.
+// Like the `browse` URL, the server section respects `browse_server` in config.
+func GenerateServerDashboardURL(server, id string) string {
+	return fmt.Sprintf("%s/secure/Dashboard.jspa?selectPageId=%s", browseServer(server), id)
+}
+
+func browseServer(server string) string {
 	if viper.GetString("browse_server") != "" {
-		server = viper.GetString("browse_server")
+		return viper.GetString("browse_server")
 	}
-	return fmt.Sprintf("%s/browse/%s", server, key)
+	return jira.JoinContextPath(server, viper.GetString("context_path"))
 }
 
 // FormatDateTimeHuman formats date time in human readable format.
@@ -146,15 +201,117 @@ func ReadFile(filePath string) ([]byte, error) {
 	return []byte(""), nil
 }
 
-// GetJiraIssueKey constructs actual issue key based on given key.
-func GetJiraIssueKey(project, key string) string {
-	if project == "" {
-		return key
+// ReadBodyInput reads body passed to the body flags. Minus (-) reads the body from stdin
+// and @path reads it from the file if it exists, eg: @desc.md. Any other value, including
+// text that merely starts with @ like a mention, eg: @alice, is returned as is.
+func ReadBodyInput(value string) (string, error) {
+	if value != "-" && (!strings.HasPrefix(value, "@") || strings.ContainsAny(value, " \t\n")) {
+		return value, nil
 	}
-	if _, err := strconv.Atoi(key); err != nil {
+	path := strings.TrimPrefix(value, "@")
+	if path == "" {
+		return "", fmt.Errorf("file path is required after @, use - to read from stdin")
+	}
+	if value != "-" {
+		if _, err := os.Stat(path); err != nil {
+			return value, nil
+		}
+	}
+	b, err := ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// GetJiraIssueKey constructs actual issue key based on given key. Purely numeric keys
+// are prefixed with the project, eg: 123 becomes PROJ-123, other keys are upper cased.
+func GetJiraIssueKey(project, key string) string {
+	key = strings.TrimSpace(key)
+	if project == "" || !isNumeric(key) {
 		return strings.ToUpper(key)
 	}
-	return fmt.Sprintf("%s-%s", project, key)
+	return fmt.Sprintf("%s-%s", strings.ToUpper(project), key)
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+var issueKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-\d+$`)
+
+// IsValidIssueKey checks if the key looks like an issue key, eg: PROJ-123.
+// Numeric issue ids are also accepted as the API works with both.
+func IsValidIssueKey(key string) bool {
+	return issueKeyRegex.MatchString(key) || isNumeric(key)
+}
+
+// ValidateIssueKeys returns an error for the first key that is not a valid issue key
+// so that the command can fail early instead of with a confusing 404 from the server.
+func ValidateIssueKeys(keys ...string) error {
+	for _, k := range keys {
+		if !IsValidIssueKey(k) {
+			return &ValidationError{Msg: fmt.Sprintf("invalid issue key: %s", k)}
+		}
+	}
+	return nil
+}
+
+// GetJiraIssueKeys constructs actual issue keys from a comma separated list of keys.
+func GetJiraIssueKeys(project, keys string) []string {
+	out := make([]string, 0)
+	for _, key := range strings.Split(keys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			out = append(out, GetJiraIssueKey(project, key))
+		}
+	}
+	return out
+}
+
+// BulkError groups failures of an operation on multiple issues into a single error.
+// It returns the number of issues the operation succeeded for and nil if nothing failed.
+// Results of requests skipped in dry run mode are ignored, jira.ErrDryRun is returned
+// if no other request failed.
+func BulkError(results []jira.BulkResult) (int, error) {
+	var (
+		failed strings.Builder
+		passed int
+		dryRun bool
+	)
+
+	for _, r := range results {
+		if errors.Is(r.Err, jira.ErrDryRun) {
+			dryRun = true
+			continue
+		}
+		if r.Err != nil {
+			failed.WriteString(fmt.Sprintf("\n  - %s: %s", r.Key, NormalizeJiraError(r.Err.Error())))
+		} else {
+			passed++
+		}
+	}
+
+	if failed.Len() > 0 {
+		return passed, &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+	if dryRun {
+		return passed, jira.ErrDryRun
+	}
+	return passed, nil
+}
+
+// InterruptContext returns a context that is canceled when the user interrupts
+// the command, eg: with Ctrl-C, so that long-running operations can stop early.
+func InterruptContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt)
 }
 
 // NormalizeJiraError normalizes error message we receive from jira.
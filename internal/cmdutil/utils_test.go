@@ -1,7 +1,9 @@
 package cmdutil
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -124,6 +126,24 @@ func TestGetJiraIssueKey(t *testing.T) {
 			input:    "11",
 			expected: "11",
 		},
+		{
+			name:     "lower case key without project",
+			project:  "",
+			input:    "ank-11",
+			expected: "ANK-11",
+		},
+		{
+			name:     "lower case project",
+			project:  "ank",
+			input:    " 11 ",
+			expected: "ANK-11",
+		},
+		{
+			name:     "signed number is not a key number",
+			project:  "ANK",
+			input:    "-11",
+			expected: "-11",
+		},
 	}
 
 	for _, tc := range cases {
@@ -137,6 +157,48 @@ func TestGetJiraIssueKey(t *testing.T) {
 	}
 }
 
+func TestGetJiraIssueKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"ANK-1", "ANK-2", "POK-3"}, GetJiraIssueKeys("ANK", "1, ank-2,,POK-3"))
+	assert.Equal(t, []string{}, GetJiraIssueKeys("ANK", ""))
+}
+
+func TestValidateIssueKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsValidIssueKey("ANK-1"))
+	assert.True(t, IsValidIssueKey("AN2_K-123"))
+	assert.True(t, IsValidIssueKey("10001"))
+	assert.False(t, IsValidIssueKey("ANK"))
+	assert.False(t, IsValidIssueKey("ANK-"))
+	assert.False(t, IsValidIssueKey("A-1"))
+	assert.False(t, IsValidIssueKey("1ANK-1"))
+	assert.False(t, IsValidIssueKey("ANK-1 "))
+
+	assert.NoError(t, ValidateIssueKeys("ANK-1", "POK-2"))
+	assert.EqualError(t, ValidateIssueKeys("ANK-1", "foo", "bar"), "invalid issue key: foo")
+}
+
+func TestBulkError(t *testing.T) {
+	t.Parallel()
+
+	passed, err := BulkError([]jira.BulkResult{{Key: "ANK-1"}, {Key: "ANK-2"}})
+	assert.Equal(t, 2, passed)
+	assert.NoError(t, err)
+
+	passed, err = BulkError([]jira.BulkResult{
+		{Key: "ANK-1"},
+		{Key: "ANK-2", Err: errors.New("Error:\n- Issue does not exist")},
+	})
+	assert.Equal(t, 1, passed)
+	assert.EqualError(t, err, "\n  - ANK-2: Issue does not exist")
+
+	passed, err = BulkError([]jira.BulkResult{{Key: "ANK-1", Err: jira.ErrDryRun}, {Key: "ANK-2", Err: jira.ErrDryRun}})
+	assert.Equal(t, 0, passed)
+	assert.ErrorIs(t, err, jira.ErrDryRun)
+}
+
 func TestNormalizeJiraError(t *testing.T) {
 	t.Parallel()
 
@@ -264,3 +326,34 @@ func TestGetSubtaskHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestReadBodyInput(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "desc.md")
+	assert.NoError(t, os.WriteFile(path, []byte("# Description"), 0o600))
+
+	body, err := ReadBodyInput("Plain description")
+	assert.NoError(t, err)
+	assert.Equal(t, "Plain description", body)
+
+	body, err = ReadBodyInput("@" + path)
+	assert.NoError(t, err)
+	assert.Equal(t, "# Description", body)
+
+	body, err = ReadBodyInput("@john can you check?")
+	assert.NoError(t, err)
+	assert.Equal(t, "@john can you check?", body)
+
+	_, err = ReadBodyInput("@")
+	assert.Error(t, err)
+
+	body, err = ReadBodyInput("@alice")
+	assert.NoError(t, err)
+	assert.Equal(t, "@alice", body)
+
+	missing := "@" + filepath.Join(t.TempDir(), "missing.md")
+	body, err = ReadBodyInput(missing)
+	assert.NoError(t, err)
+	assert.Equal(t, missing, body)
+}
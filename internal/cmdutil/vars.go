@@ -0,0 +1,32 @@
+package cmdutil
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+var varPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ExpandVars replaces ${VAR} placeholders in s with the value from vars, falling back
+// to the environment variable of the same name. Other uses of $, eg: $VAR or $100, are
+// left as is. An error is returned if a placeholder can't be resolved.
+func ExpandVars(s string, vars map[string]string) (string, error) {
+	var missing []string
+
+	out := varPattern.ReplaceAllStringFunc(s, func(m string) string {
+		name := varPattern.FindStringSubmatch(m)[1]
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		missing = append(missing, name)
+		return m
+	})
+	if len(missing) > 0 {
+		return "", &ValidationError{Msg: fmt.Sprintf("variable %s is not defined, pass it with --var %s=<value> or set it in the environment", missing[0], missing[0])}
+	}
+	return out, nil
+}
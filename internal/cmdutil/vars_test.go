@@ -0,0 +1,60 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandVars(t *testing.T) {
+	t.Setenv("JIRA_TEST_RELEASE", "v1.2.0")
+
+	cases := []struct {
+		name     string
+		input    string
+		vars     map[string]string
+		expected string
+		err      bool
+	}{
+		{
+			name:     "no variables",
+			input:    "Costs $100 and $HOME is not expanded",
+			expected: "Costs $100 and $HOME is not expanded",
+		},
+		{
+			name:     "from vars",
+			input:    "Crash on ${PAGE} page",
+			vars:     map[string]string{"PAGE": "login"},
+			expected: "Crash on login page",
+		},
+		{
+			name:     "vars take precedence over env",
+			input:    "Release ${JIRA_TEST_RELEASE}",
+			vars:     map[string]string{"JIRA_TEST_RELEASE": "v2.0.0"},
+			expected: "Release v2.0.0",
+		},
+		{
+			name:     "from env",
+			input:    "Release ${JIRA_TEST_RELEASE}",
+			expected: "Release v1.2.0",
+		},
+		{
+			name:  "undefined",
+			input: "Crash on ${JIRA_TEST_UNDEFINED} page",
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ExpandVars(tc.input, tc.vars)
+			if tc.err {
+				assert.Error(t, err)
+				assert.Equal(t, ExitValidation, ExitCode(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
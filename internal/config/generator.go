@@ -63,15 +63,16 @@ type JiraCLIMTLSConfig struct {
 
 // JiraCLIConfig is a Jira CLI config.
 type JiraCLIConfig struct {
-	Installation string
-	Server       string
-	AuthType     string
-	Login        string
-	Project      string
-	Board        string
-	Force        bool
-	Insecure     bool
-	MTLS         JiraCLIMTLSConfig
+	Installation  string
+	Server        string
+	AgileEndpoint string
+	AuthType      string
+	Login         string
+	Project       string
+	Board         string
+	Force         bool
+	Insecure      bool
+	MTLS          JiraCLIMTLSConfig
 }
 
 // JiraCLIConfigGenerator is a Jira CLI config generator.
@@ -418,11 +419,12 @@ func (c *JiraCLIConfigGenerator) verifyLoginDetails(server, login string) error
 	server = strings.TrimRight(server, "/")
 
 	c.jiraClient = api.Client(jira.Config{
-		Server:   server,
-		Login:    login,
-		Insecure: &c.usrCfg.Insecure,
-		AuthType: &c.value.authType,
-		Debug:    viper.GetBool("debug"),
+		Server:            server,
+		JiraAgileEndpoint: c.usrCfg.AgileEndpoint,
+		Login:             login,
+		Insecure:          &c.usrCfg.Insecure,
+		AuthType:          &c.value.authType,
+		Debug:             viper.GetBool("debug"),
 		MTLSConfig: jira.MTLSConfig{
 			CaCert:     c.value.mtls.caCert,
 			ClientCert: c.value.mtls.clientCert,
@@ -451,11 +453,12 @@ func (c *JiraCLIConfigGenerator) configureServerMeta(server, login string) error
 	server = strings.TrimRight(server, "/")
 
 	c.jiraClient = api.Client(jira.Config{
-		Server:   server,
-		Login:    login,
-		Insecure: &c.usrCfg.Insecure,
-		AuthType: &c.value.authType,
-		Debug:    viper.GetBool("debug"),
+		Server:            server,
+		JiraAgileEndpoint: c.usrCfg.AgileEndpoint,
+		Login:             login,
+		Insecure:          &c.usrCfg.Insecure,
+		AuthType:          &c.value.authType,
+		Debug:             viper.GetBool("debug"),
 		MTLSConfig: jira.MTLSConfig{
 			CaCert:     c.value.mtls.caCert,
 			ClientCert: c.value.mtls.clientCert,
@@ -753,6 +756,12 @@ func (c *JiraCLIConfigGenerator) write(path string) (string, error) {
 
 	config.Set("installation", c.value.installation)
 	config.Set("server", c.value.server)
+
+	// The agile endpoint is derived from the server unless it is served elsewhere.
+	if ep := jira.AgileEndpoint(c.value.server, c.usrCfg.AgileEndpoint); ep != strings.TrimSuffix(c.value.server, "/") {
+		config.Set("agile_endpoint", ep)
+	}
+
 	config.Set("login", c.value.login)
 	config.Set("project", c.value.project)
 	config.Set("epic", c.value.epic)
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ProfileKey is the config key that holds the default profile.
+	ProfileKey = "profile"
+	// ProfilesKey is the config key that holds named profiles.
+	ProfilesKey = "profiles"
+)
+
+// ErrProfileNotFound is returned if the requested profile doesn't exist in the config.
+var ErrProfileNotFound = fmt.Errorf("profile not found")
+
+// Profiles returns sorted names of the profiles defined in the config.
+func Profiles(v *viper.Viper) []string {
+	profiles := v.GetStringMap(ProfilesKey)
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// ApplyProfile overrides top level config values with the values of the given profile.
+//
+// A profile can hold any key that can be set at the top level, eg: server, login,
+// auth_type, installation and project. Keys missing in the profile fall back to
+// the top level values.
+func ApplyProfile(v *viper.Viper, name string) error {
+	if !slices.Contains(Profiles(v), name) {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+	return v.MergeConfigMap(v.GetStringMap(ProfilesKey + "." + name))
+}
+
+// SetDefaultProfile persists the profile to use when none is given in the config file.
+func SetDefaultProfile(file, name string) error {
+	config := viper.New()
+	config.SetConfigFile(file)
+
+	if err := config.ReadInConfig(); err != nil {
+		return err
+	}
+	if !slices.Contains(Profiles(config), name) {
+		return fmt.Errorf("%w: %s", ErrProfileNotFound, name)
+	}
+	config.Set(ProfileKey, name)
+
+	return config.WriteConfig()
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const profileConfig = `server: https://default.atlassian.net
+login: default@example.com
+installation: Cloud
+project:
+  key: DEF
+  type: classic
+profiles:
+  work:
+    server: https://work.example.com
+    login: work@example.com
+    installation: Local
+    project:
+      key: WRK
+  personal:
+    server: https://personal.atlassian.net
+`
+
+func TestProfiles(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yml")
+	assert.NoError(t, v.ReadConfig(strings.NewReader(profileConfig)))
+
+	assert.Equal(t, []string{"personal", "work"}, Profiles(v))
+}
+
+func TestApplyProfile(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yml")
+	assert.NoError(t, v.ReadConfig(strings.NewReader(profileConfig)))
+
+	assert.NoError(t, ApplyProfile(v, "work"))
+
+	assert.Equal(t, "https://work.example.com", v.GetString("server"))
+	assert.Equal(t, "work@example.com", v.GetString("login"))
+	assert.Equal(t, "Local", v.GetString("installation"))
+	assert.Equal(t, "WRK", v.GetString("project.key"))
+	assert.Equal(t, "classic", v.GetString("project.type"))
+
+	err := ApplyProfile(v, "unknown")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestSetDefaultProfile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".config.yml")
+	assert.NoError(t, os.WriteFile(file, []byte(profileConfig), 0o600))
+
+	assert.NoError(t, SetDefaultProfile(file, "personal"))
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	assert.NoError(t, v.ReadInConfig())
+	assert.Equal(t, "personal", v.GetString(ProfileKey))
+	assert.Equal(t, "https://default.atlassian.net", v.GetString("server"))
+
+	err := SetDefaultProfile(file, "unknown")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+}
@@ -2,10 +2,13 @@ package query
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/spf13/viper"
+
 	"github.com/ankitpokhrel/jira-cli/pkg/jql"
 )
 
@@ -19,6 +22,12 @@ type Issue struct {
 
 const defaultLimit = 100
 
+// dateOperators are comparison operators supported in date filters, longest first.
+var dateOperators = []string{">=", "<=", ">", "<"}
+
+// relativeDateRegex matches a relative period supported by JQL, eg: -7d or 4w.
+var relativeDateRegex = regexp.MustCompile(`^[-+]?\d+[wdhm]$`)
+
 // NewIssue creates and initializes a new Issue type.
 func NewIssue(project string, flags FlagParser) (*Issue, error) {
 	ip := IssueParams{}
@@ -61,6 +70,9 @@ func (i *Issue) Get() string {
 		(i.params.Created == "" && i.params.CreatedBefore == "" && i.params.CreatedAfter == "") {
 		obf = "updated"
 	}
+	if i.params.Mine && obf == "created" {
+		obf = "updated"
+	}
 
 	if i.params.JQL != "" {
 		q.Raw(i.params.JQL)
@@ -74,9 +86,17 @@ func (i *Issue) Get() string {
 		if i.params.Watching {
 			q.Watching()
 		}
+		if i.params.Mine {
+			q.Mine()
+		}
+
+		resolution := i.params.Resolution
+		if i.params.Mine && resolution == "" {
+			resolution = "x"
+		}
 
 		q.FilterBy("type", i.params.IssueType).
-			FilterBy("resolution", i.params.Resolution).
+			FilterBy("resolution", resolution).
 			FilterBy("priority", i.params.Priority).
 			FilterBy("reporter", i.params.Reporter).
 			FilterBy("assignee", i.params.Assignee).
@@ -139,9 +159,49 @@ func (*Issue) setDateFilters(q *jql.JQL, field, value string) {
 	}
 }
 
+// buildDateFilter adds a date filter to the query. An expression prefixed with a comparison
+// operator, eg: >-7d or <=2020-12-31, is compared as is. Otherwise, the expression is a
+// keyword like today or week, a date, or a period that matches issues since then.
+func (i *Issue) buildDateFilter(q *jql.JQL, field, expr string) {
+	op, value, _ := splitDateExpr(expr)
+
+	switch op {
+	case ">":
+		q.Gt(field, value, true)
+	case ">=":
+		q.Gte(field, value, true)
+	case "<":
+		q.Lt(field, value, true)
+	case "<=":
+		q.Lte(field, value, true)
+	default:
+		i.setDateFilters(q, field, value)
+	}
+}
+
+// splitDateExpr splits a date filter expression like >-7d into the comparison operator
+// and the value. The value of an expression with an operator must be a relative period,
+// eg: -7d, or a date. The operator is empty if the expression doesn't have one.
+func splitDateExpr(expr string) (string, string, error) {
+	for _, op := range dateOperators {
+		value, ok := strings.CutPrefix(expr, op)
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if _, _, isDate := isValidDate(value); !isDate && !relativeDateRegex.MatchString(value) {
+			return "", "", fmt.Errorf(
+				"invalid date filter %q: expected a period like %s-7d or a date like %s2020-12-31", expr, op, op,
+			)
+		}
+		return op, value, nil
+	}
+	return "", expr, nil
+}
+
 func (i *Issue) setCreatedFilters(q *jql.JQL) {
 	if i.params.Created != "" {
-		i.setDateFilters(q, "createdDate", i.params.Created)
+		i.buildDateFilter(q, "createdDate", i.params.Created)
 		return
 	}
 	if i.params.CreatedAfter != "" {
@@ -154,7 +214,7 @@ func (i *Issue) setCreatedFilters(q *jql.JQL) {
 
 func (i *Issue) setUpdatedFilters(q *jql.JQL) {
 	if i.params.Updated != "" {
-		i.setDateFilters(q, "updatedDate", i.params.Updated)
+		i.buildDateFilter(q, "updatedDate", i.params.Updated)
 		return
 	}
 	if i.params.UpdatedAfter != "" {
@@ -169,6 +229,7 @@ func (i *Issue) setUpdatedFilters(q *jql.JQL) {
 type IssueParams struct {
 	Latest        bool
 	Watching      bool
+	Mine          bool
 	Resolution    string
 	IssueType     string
 	Parent        string
@@ -196,7 +257,7 @@ type IssueParams struct {
 func (ip *IssueParams) init(flags FlagParser) error {
 	var err error
 
-	boolParams := []string{"history", "watching", "reverse", "debug"}
+	boolParams := []string{"history", "watching", "mine", "reverse", "debug"}
 	stringParams := []string{
 		"resolution", "type", "parent", "priority", "reporter", "assignee", "component",
 		"created", "created-after", "created-before", "updated", "updated-after", "updated-before",
@@ -231,11 +292,17 @@ func (ip *IssueParams) init(flags FlagParser) error {
 	if err != nil {
 		return err
 	}
-	from, limit, err := getPaginateParams(paginate)
+	from, limit, err := GetPaginateParams(paginate)
 	if err != nil {
 		return err
 	}
 
+	for _, param := range []string{"created", "updated"} {
+		if _, _, err := splitDateExpr(stringParamsMap[param]); err != nil {
+			return err
+		}
+	}
+
 	ip.setBoolParams(boolParamsMap)
 	ip.setStringParams(stringParamsMap)
 	ip.Labels = labels
@@ -253,6 +320,8 @@ func (ip *IssueParams) setBoolParams(paramsMap map[string]bool) {
 			ip.Latest = v
 		case "watching":
 			ip.Watching = v
+		case "mine":
+			ip.Mine = v
 		case "reverse":
 			ip.Reverse = v
 		case "debug":
@@ -318,7 +387,10 @@ func addDay(dt time.Time, format string) string {
 	return dt.AddDate(0, 0, 1).Format(format)
 }
 
-func getPaginateParams(paginate string) (uint, uint, error) {
+// GetPaginateParams parses a paginate argument in <from>:<limit> format where
+// <from> is optional. The limit can't exceed 100 and defaults to `max_results`
+// in the config, or 100 if it isn't set.
+func GetPaginateParams(paginate string) (uint, uint, error) {
 	var (
 		err         error
 		from, limit int
@@ -335,6 +407,9 @@ func getPaginateParams(paginate string) (uint, uint, error) {
 	paginate = strings.TrimSpace(paginate)
 
 	if paginate == "" {
+		if n := viper.GetInt("max_results"); n > 0 {
+			return 0, uint(n), nil
+		}
 		return 0, defaultLimit, nil
 	}
 
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,6 +36,7 @@ type issueFlagParser struct {
 	updatedBefore string
 	jql           string
 	orderBy       string
+	mine          bool
 }
 
 func (tfp *issueFlagParser) GetBool(name string) (bool, error) {
@@ -44,6 +46,9 @@ func (tfp *issueFlagParser) GetBool(name string) (bool, error) {
 	if tfp.err.watching && name == "watching" {
 		return false, fmt.Errorf("oops! couldn't fetch watching flag")
 	}
+	if name == "mine" {
+		return tfp.mine, nil
+	}
 	if tfp.noHistory && name == "history" {
 		return false, nil
 	}
@@ -165,6 +170,17 @@ func TestIssueGet(t *testing.T) {
 				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
 				`AND assignee="test" AND component="test" AND parent="test" ORDER BY created ASC`,
 		},
+		{
+			name: "query with mine parameter",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{noHistory: true, noWatching: true, mine: true})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND assignee=currentUser() AND ` +
+				`type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" ORDER BY updated ASC`,
+		},
 		{
 			name: "query with error when fetching history flag",
 			initialize: func() *Issue {
@@ -187,6 +203,15 @@ func TestIssueGet(t *testing.T) {
 			},
 			expected: "",
 		},
+		{
+			name: "query with error for invalid relative date filter",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{created: ">-7days"})
+				assert.EqualError(t, err, `invalid date filter ">-7days": expected a period like >-7d or a date like >2020-12-31`)
+				return i
+			},
+			expected: "",
+		},
 		{
 			name: "query with error when fetching resolution flag",
 			initialize: func() *Issue {
@@ -342,6 +367,17 @@ func TestIssueGet(t *testing.T) {
 				`type="test" AND resolution="test" AND priority="test" AND reporter="test" AND assignee="test" ` +
 				`AND component="test" AND parent="test" AND createdDate>="2020-15-31" AND updatedDate>="2020-12-31 10:30:30" ORDER BY lastViewed ASC`,
 		},
+		{
+			name: "query with relative created and updated filter",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{created: ">-7d", updated: "<= 2020-12-31", noHistory: true, noWatching: true})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND type="test" AND resolution="test" AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" AND createdDate>"-7d" AND updatedDate<="2020-12-31" ` +
+				`ORDER BY created ASC`,
+		},
 		{
 			name: "query with created-after and created-before filter",
 			initialize: func() *Issue {
@@ -447,3 +483,27 @@ func TestIssueGet(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPaginateParams(t *testing.T) {
+	from, limit, err := GetPaginateParams("")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), from)
+	assert.Equal(t, uint(100), limit)
+
+	viper.Set("max_results", 50)
+	defer viper.Reset()
+
+	from, limit, err = GetPaginateParams("")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), from)
+	assert.Equal(t, uint(50), limit)
+
+	// An explicit limit takes precedence over the configured one.
+	from, limit, err = GetPaginateParams("10:20")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(10), from)
+	assert.Equal(t, uint(20), limit)
+
+	_, _, err = GetPaginateParams("101")
+	assert.Error(t, err)
+}
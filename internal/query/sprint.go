@@ -100,7 +100,7 @@ func (sp *SprintParams) init(flags FlagParser) error {
 	if err != nil {
 		return err
 	}
-	from, limit, err := getPaginateParams(paginate)
+	from, limit, err := GetPaginateParams(paginate)
 	if err != nil {
 		return err
 	}
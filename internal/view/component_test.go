@@ -0,0 +1,30 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestComponentRender(t *testing.T) {
+	var b bytes.Buffer
+
+	backend := &jira.Component{ID: "10000", Name: "Backend", Description: "Server side code"}
+	backend.Lead.Name = "Person A"
+
+	data := []*jira.Component{
+		backend,
+		{ID: "10001", Name: "Frontend"},
+	}
+	component := NewComponent(data, WithComponentWriter(&b))
+	assert.NoError(t, component.Render())
+
+	expected := `ID	NAME	LEAD	DESCRIPTION
+10000	Backend	Person A	Server side code
+10001	Frontend		
+`
+	assert.Equal(t, expected, b.String())
+}
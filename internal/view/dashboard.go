@@ -0,0 +1,88 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// DashboardOption is a functional option to wrap dashboard properties.
+type DashboardOption func(*Dashboard)
+
+// Dashboard is a dashboard view.
+type Dashboard struct {
+	data   []*jira.Dashboard
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewDashboard initializes a dashboard view.
+func NewDashboard(data []*jira.Dashboard, opts ...DashboardOption) *Dashboard {
+	d := Dashboard{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	d.writer = tabwriter.NewWriter(d.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&d)
+	}
+	return &d
+}
+
+// WithDashboardWriter sets a writer for the dashboard view.
+func WithDashboardWriter(w io.Writer) DashboardOption {
+	return func(d *Dashboard) {
+		d.writer = w
+	}
+}
+
+// Render renders the dashboard view.
+func (d Dashboard) Render() error {
+	d.printHeader()
+
+	for _, v := range d.data {
+		owner := ""
+		if v.Owner != nil {
+			owner = v.Owner.DisplayName
+		}
+		favourite := ""
+		if v.Favourite {
+			favourite = "★"
+		}
+		_, _ = fmt.Fprintf(d.writer, "%s\t%s\t%s\t%s\n", v.ID, prepareTitle(v.Name), owner, favourite)
+	}
+	if _, ok := d.writer.(*tabwriter.Writer); ok {
+		err := d.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(d.buf.String())
+}
+
+func (d Dashboard) header() []string {
+	return []string{
+		"ID",
+		"NAME",
+		"OWNER",
+		"FAVOURITE",
+	}
+}
+
+func (d Dashboard) printHeader() {
+	headers := d.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(d.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(d.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(d.writer)
+}
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestDashboardRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Dashboard{
+		{ID: "10000", Name: "Team board", Favourite: true, Owner: &jira.User{DisplayName: "Person A"}},
+		{ID: "10001", Name: "Release health"},
+	}
+	dashboard := NewDashboard(data, WithDashboardWriter(&b))
+	assert.NoError(t, dashboard.Render())
+
+	expected := `ID	NAME	OWNER	FAVOURITE
+10000	Team board	Person A	★
+10001	Release health		
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -39,6 +39,7 @@ func (el *EpicList) Render() error {
 		tui.WithContentTableOpts(
 			tui.WithTableStyle(el.Display.TableStyle),
 			tui.WithFixedColumns(el.Display.FixedColumns),
+			tui.WithMaxColWidth(el.Display.MaxColWidth),
 			tui.WithSelectedFunc(navigate(el.Server)),
 			tui.WithViewModeFunc(func(r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
 				dataFn := func() interface{} {
@@ -114,8 +115,8 @@ func (el *EpicList) tabularize(issues []*jira.Issue) tui.TableData {
 			issue.Fields.Reporter.Name,
 			issue.Fields.Priority.Name,
 			issue.Fields.Resolution.Name,
-			formatDateTime(issue.Fields.Created, jira.RFC3339, el.Display.Timezone),
-			formatDateTime(issue.Fields.Updated, jira.RFC3339, el.Display.Timezone),
+			el.Display.dateTime(issue.Fields.Created, jira.RFC3339),
+			el.Display.dateTime(issue.Fields.Updated, jira.RFC3339),
 		})
 	}
 
@@ -27,9 +27,7 @@ func TestEpicData(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Status:  jira.IssueStatus{Name: "Done"},
 			Created: "2020-12-13T14:05:20.974+0100",
 			Updated: "2020-12-13T14:07:20.974+0100",
 		},
@@ -45,9 +43,7 @@ func TestEpicData(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person A"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Open"},
+			Status:  jira.IssueStatus{Name: "Open"},
 			Created: "2020-12-13T14:05:20.974+0100",
 			Updated: "2020-12-13T14:07:20.974+0100",
 		},
@@ -70,9 +66,7 @@ func TestEpicData(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Status:  jira.IssueStatus{Name: "Done"},
 			Created: "2020-12-13T14:05:20.974+0100",
 			Updated: "2020-12-13T14:07:20.974+0100",
 		},
@@ -88,9 +82,7 @@ func TestEpicData(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person A"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Open"},
+			Status:  jira.IssueStatus{Name: "Open"},
 			Created: "2020-12-13T14:05:20.974+0100",
 			Updated: "2020-12-13T14:07:20.974+0100",
 		},
@@ -0,0 +1,89 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// FilterOption is a functional option to wrap filter properties.
+type FilterOption func(*Filter)
+
+// Filter is a saved filter view.
+type Filter struct {
+	data   []*jira.Filter
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewFilter initializes a saved filter view.
+func NewFilter(data []*jira.Filter, opts ...FilterOption) *Filter {
+	f := Filter{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	f.writer = tabwriter.NewWriter(f.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return &f
+}
+
+// WithFilterWriter sets a writer for the filter view.
+func WithFilterWriter(w io.Writer) FilterOption {
+	return func(f *Filter) {
+		f.writer = w
+	}
+}
+
+// Render renders the saved filter view.
+func (f Filter) Render() error {
+	f.printHeader()
+
+	for _, d := range f.data {
+		owner := ""
+		if d.Owner != nil {
+			owner = d.Owner.DisplayName
+		}
+		favourite := ""
+		if d.Favourite {
+			favourite = "★"
+		}
+		_, _ = fmt.Fprintf(f.writer, "%s\t%s\t%s\t%s\t%s\n", d.ID, prepareTitle(d.Name), owner, favourite, d.JQL)
+	}
+	if _, ok := f.writer.(*tabwriter.Writer); ok {
+		err := f.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(f.buf.String())
+}
+
+func (f Filter) header() []string {
+	return []string{
+		"ID",
+		"NAME",
+		"OWNER",
+		"FAVOURITE",
+		"JQL",
+	}
+}
+
+func (f Filter) printHeader() {
+	headers := f.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(f.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(f.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(f.writer)
+}
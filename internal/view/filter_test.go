@@ -0,0 +1,27 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFilterRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Filter{
+		{ID: "10000", Name: "My open issues", JQL: "assignee = currentUser()", Favourite: true, Owner: &jira.User{DisplayName: "Person A"}},
+		{ID: "10001", Name: "Team bugs", JQL: "project = TEST AND type = Bug"},
+	}
+	filter := NewFilter(data, WithFilterWriter(&b))
+	assert.NoError(t, filter.Render())
+
+	expected := `ID	NAME	OWNER	FAVOURITE	JQL
+10000	My open issues	Person A	★	assignee = currentUser()
+10001	Team bugs			project = TEST AND type = Bug
+`
+	assert.Equal(t, expected, b.String())
+}
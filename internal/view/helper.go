@@ -13,11 +13,13 @@ import (
 	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/glamour"
 	"github.com/fatih/color"
+	"github.com/gdamore/tcell/v2"
 	"github.com/mgutz/ansi"
 	"github.com/rivo/tview"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
@@ -77,6 +79,31 @@ func ValidIssueColumns() []string {
 	}
 }
 
+// IssueColumnFields returns Jira fields needed to display the given issue list columns.
+// Unknown columns are ignored, and the key is always part of the response.
+func IssueColumnFields(columns []string) []string {
+	fieldMap := map[string]string{
+		fieldType:       "issuetype",
+		fieldSummary:    "summary",
+		fieldStatus:     "status",
+		fieldAssignee:   "assignee",
+		fieldReporter:   "reporter",
+		fieldPriority:   "priority",
+		fieldResolution: "resolution",
+		fieldCreated:    "created",
+		fieldUpdated:    "updated",
+		fieldLabels:     "labels",
+	}
+
+	var fields []string
+	for _, c := range columns {
+		if f, ok := fieldMap[strings.ToUpper(strings.TrimSpace(c))]; ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // ValidSprintColumns returns valid columns for sprint list.
 func ValidSprintColumns() []string {
 	return []string{
@@ -97,19 +124,58 @@ func MDRenderer() (*glamour.TermRenderer, error) {
 	)
 }
 
-func formatDateTime(dt, format, tz string) string {
+// formatDateTimeLayout parses the datetime in the given format and displays it in the
+// given timezone using the layout. Layout can also be DateFormatRelative, eg: 3 days ago.
+func formatDateTimeLayout(dt, format, tz, layout string) string {
 	t, err := time.Parse(format, dt)
 	if err != nil {
 		return dt
 	}
-	if tz == "" {
-		return t.Format("2006-01-02 15:04:05")
+	if tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return dt
+		}
+		t = t.In(loc)
 	}
-	loc, err := time.LoadLocation(tz)
-	if err != nil {
-		return dt
+	if layout == DateFormatRelative {
+		return formatRelative(t, time.Now())
+	}
+	return t.Format(layout)
+}
+
+//nolint:mnd
+func formatRelative(t, now time.Time) string {
+	d := now.Sub(t)
+
+	suffix := "ago"
+	if d < 0 {
+		d, suffix = -d, "from now"
+	}
+
+	plural := func(n int, unit string) string {
+		if n != 1 {
+			unit += "s"
+		}
+		return fmt.Sprintf("%d %s %s", n, unit, suffix)
+	}
+
+	day := 24 * time.Hour
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return plural(int(d/time.Minute), "minute")
+	case d < day:
+		return plural(int(d/time.Hour), "hour")
+	case d < 30*day:
+		return plural(int(d/day), "day")
+	case d < 365*day:
+		return plural(int(d/(30*day)), "month")
+	default:
+		return plural(int(d/(365*day)), "year")
 	}
-	return t.In(loc).Format("2006-01-02 15:04:05")
 }
 
 func prepareTitle(text string) string {
@@ -170,6 +236,67 @@ func renderPlain(w io.Writer, data tui.TableData, delimiter string) error {
 	return nil
 }
 
+// minColWidth is the width below which columns are not shrunk to fit the terminal.
+const minColWidth = 10
+
+// fitColumns truncates values in the table so that no column is wider than maxWidth
+// and, if termWidth is set, each row fits in the terminal by shrinking the widest
+// columns first. Widths are measured in terminal columns, taking the tab stops of
+// the plain view into account. Zero disables the respective limit.
+func fitColumns(data tui.TableData, maxWidth, termWidth int) tui.TableData {
+	if len(data) == 0 || (maxWidth <= 0 && termWidth <= 0) {
+		return data
+	}
+
+	out := make(tui.TableData, 0, len(data))
+	for _, items := range data {
+		row := make([]string, 0, len(items))
+		for _, v := range items {
+			row = append(row, tui.Truncate(unescape(v), maxWidth))
+		}
+		out = append(out, row)
+	}
+	if termWidth <= 0 {
+		return out
+	}
+
+	widths := make([]int, len(out[0]))
+	for _, row := range out {
+		for j, v := range row {
+			if j < len(widths) {
+				widths[j] = max(widths[j], tui.TextWidth(v))
+			}
+		}
+	}
+
+	for {
+		total, widest := 0, 0
+		for j, w := range widths {
+			if j == len(widths)-1 {
+				total += w
+			} else {
+				// Cells are padded with tabs up to the next tab stop.
+				total += (w/tabWidth + 1) * tabWidth
+			}
+			if w > widths[widest] {
+				widest = j
+			}
+		}
+		if total <= termWidth || widths[widest] <= minColWidth {
+			break
+		}
+
+		widths[widest] = max(minColWidth, min(widths[widest]-1, widths[widest]-(total-termWidth)))
+		for _, row := range out {
+			if widest < len(row) {
+				row[widest] = tui.Truncate(row[widest], widths[widest])
+			}
+		}
+	}
+
+	return out
+}
+
 func renderCSV(w io.Writer, data tui.TableData) error {
 	csvwrt := csv.NewWriter(w)
 
@@ -191,6 +318,73 @@ func unescape(s string) string {
 	return pattern.ReplaceAllString(s, "$1]")
 }
 
+// fgDefault resets the foreground to the default color of the terminal.
+const fgDefault color.Attribute = 39
+
+// statusColors are the colors of the statuses by category,
+// To Do is blue, In Progress is yellow and Done is green.
+var statusColors = map[string]struct {
+	term color.Attribute
+	tui  tcell.Color
+}{
+	jira.StatusCategoryToDo:       {term: color.FgBlue, tui: tcell.ColorBlue},
+	jira.StatusCategoryInProgress: {term: color.FgYellow, tui: tcell.ColorYellow},
+	jira.StatusCategoryDone:       {term: color.FgGreen, tui: tcell.ColorGreen},
+}
+
+// coloredStatus colors the status by its category. The status of an unknown category is
+// wrapped in the default color so that all values in a column take the same number of bytes
+// and the columns aligned by a tabwriter stay aligned. Colors are not used with --no-color,
+// the NO_COLOR env or when the output is not a terminal.
+func coloredStatus(status jira.IssueStatus, text string) string {
+	if clr, ok := statusColors[status.StatusCategory.Key]; ok {
+		return coloredOut(text, clr.term)
+	}
+	return coloredOut(text, fgDefault)
+}
+
+// statusColorFunc colors the status column of the interactive table by category.
+func statusColorFunc(data tui.TableData, issues []*jira.Issue) tui.CellColorFunc {
+	categories := make(map[string]string, len(issues))
+	for _, iss := range issues {
+		categories[iss.Fields.Status.Name] = iss.Fields.Status.StatusCategory.Key
+	}
+	idx := data.GetIndex(fieldStatus)
+
+	return func(_, c int, val string) tcell.Color {
+		if color.NoColor || c != idx {
+			return tcell.ColorDefault
+		}
+		// Look up by name as the status of the row changes when the issue is moved.
+		if clr, ok := statusColors[categories[val]]; ok {
+			return clr.tui
+		}
+		return tcell.ColorDefault
+	}
+}
+
+// colorStatusColumn colors values of the status column in the plain view.
+func colorStatusColumn(data tui.TableData, idx int, hasHeader bool, issues []*jira.Issue) tui.TableData {
+	if color.NoColor || idx < 0 {
+		return data
+	}
+	for r, row := range data {
+		if idx >= len(row) {
+			continue
+		}
+		i := r
+		if hasHeader {
+			i--
+		}
+		if i < 0 || i >= len(issues) {
+			row[idx] = coloredOut(row[idx], fgDefault)
+			continue
+		}
+		row[idx] = coloredStatus(issues[i].Fields.Status, row[idx])
+	}
+	return data
+}
+
 func coloredOut(msg string, clr color.Attribute, attrs ...color.Attribute) string {
 	c := color.New(clr).Add(attrs...)
 	return c.Sprint(msg)
@@ -212,6 +406,20 @@ func gray256(msg string) string {
 	return fmt.Sprintf("\x1b[38;5;242m%s\x1b[m", msg)
 }
 
+// formatSize formats size in bytes to a human-readable string, eg: 1.5 KB.
+func formatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
 func shortenAndPad(msg string, limit int) string {
 	if limit > 1 && len(msg) > limit {
 		return msg[0:limit-1] + "…"
@@ -2,12 +2,16 @@
 package view
 
 import (
+	"math"
 	"testing"
 	"time"
 
+	"github.com/fatih/color"
+	"github.com/gdamore/tcell/v2"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
 func TestFormatDateTime(t *testing.T) {
@@ -21,35 +25,35 @@ func TestFormatDateTime(t *testing.T) {
 		{
 			name: "it returns input date for invalid date input",
 			format: func() string {
-				return formatDateTime("2020-12-03 10:00:00", jira.RFC3339, "UTC")
+				return formatDateTimeLayout("2020-12-03 10:00:00", jira.RFC3339, "UTC", dateTimeLayout)
 			},
 			expected: "2020-12-03 10:00:00",
 		},
 		{
 			name: "it returns input date for invalid input format",
 			format: func() string {
-				return formatDateTime("2020-12-03 10:00:00", "invalid", "UTC")
+				return formatDateTimeLayout("2020-12-03 10:00:00", "invalid", "UTC", dateTimeLayout)
 			},
 			expected: "2020-12-03 10:00:00",
 		},
 		{
 			name: "it format input date from jira date format",
 			format: func() string {
-				return formatDateTime("2020-12-03T14:05:20.974+0100", jira.RFC3339, "UTC")
+				return formatDateTimeLayout("2020-12-03T14:05:20.974+0100", jira.RFC3339, "UTC", dateTimeLayout)
 			},
 			expected: "2020-12-03 13:05:20",
 		},
 		{
 			name: "it format input date from RFC3339 date format",
 			format: func() string {
-				return formatDateTime("2020-12-13T16:12:00.000Z", time.RFC3339, "UTC")
+				return formatDateTimeLayout("2020-12-13T16:12:00.000Z", time.RFC3339, "UTC", dateTimeLayout)
 			},
 			expected: "2020-12-13 16:12:00",
 		},
 		{
 			name: "it format input date using proper timezone",
 			format: func() string {
-				return formatDateTime("2020-12-13T16:12:00.000Z", time.RFC3339, "Asia/Kathmandu")
+				return formatDateTimeLayout("2020-12-13T16:12:00.000Z", time.RFC3339, "Asia/Kathmandu", dateTimeLayout)
 			},
 			expected: "2020-12-13 21:57:00",
 		},
@@ -66,6 +70,41 @@ func TestFormatDateTime(t *testing.T) {
 	}
 }
 
+func TestFormatDateTimeLayout(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "03/12/2020 13:05", formatDateTimeLayout("2020-12-03T14:05:20.974+0100", jira.RFC3339, "UTC", "02/01/2006 15:04"))
+	assert.Equal(t, "Thu, 03 Dec 20", formatDateTimeLayout("2020-12-03T14:05:20.974+0100", jira.RFC3339, "", dateLayout))
+
+	dt := time.Now().Add(-50 * time.Hour).Format(jira.RFC3339)
+	assert.Equal(t, "2 days ago", formatDateTimeLayout(dt, jira.RFC3339, "UTC", DateFormatRelative))
+}
+
+func TestFormatRelative(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		in       time.Time
+		expected string
+	}{
+		{in: now.Add(-30 * time.Second), expected: "just now"},
+		{in: now.Add(-1 * time.Minute), expected: "1 minute ago"},
+		{in: now.Add(-45 * time.Minute), expected: "45 minutes ago"},
+		{in: now.Add(-3 * time.Hour), expected: "3 hours ago"},
+		{in: now.Add(-24 * time.Hour), expected: "1 day ago"},
+		{in: now.AddDate(0, 0, -3), expected: "3 days ago"},
+		{in: now.AddDate(0, -2, -1), expected: "2 months ago"},
+		{in: now.AddDate(-3, 0, 0), expected: "3 years ago"},
+		{in: now.Add(5 * time.Hour), expected: "5 hours from now"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, formatRelative(tc.in, now))
+	}
+}
+
 func TestPrepareTitle(t *testing.T) {
 	t.Parallel()
 
@@ -159,6 +198,122 @@ func TestShortenAndPad(t *testing.T) {
 	}
 }
 
+func TestFitColumns(t *testing.T) {
+	t.Parallel()
+
+	data := func() tui.TableData {
+		return tui.TableData{
+			{"KEY", "SUMMARY", "STATUS"},
+			{"TEST-1", "This is a very long summary of the issue", "Done"},
+			{"TEST-2", "Short [one[]", "Open"},
+		}
+	}
+
+	cases := []struct {
+		name      string
+		maxWidth  int
+		termWidth int
+		expected  tui.TableData
+	}{
+		{
+			name:     "it returns data as is without limits",
+			expected: data(),
+		},
+		{
+			name:     "it shortens values longer than max width",
+			maxWidth: 10,
+			expected: tui.TableData{
+				{"KEY", "SUMMARY", "STATUS"},
+				{"TEST-1", "This is a…", "Done"},
+				{"TEST-2", "Short [on…", "Open"},
+			},
+		},
+		{
+			name:      "it shrinks the widest column to fit the terminal",
+			termWidth: 40,
+			expected: tui.TableData{
+				{"KEY", "SUMMARY", "STATUS"},
+				{"TEST-1", "This is a very lo…", "Done"},
+				{"TEST-2", "Short [one]", "Open"},
+			},
+		},
+		{
+			name:      "it doesn't shrink columns below the min width",
+			termWidth: 10,
+			expected: tui.TableData{
+				{"KEY", "SUMMARY", "STATUS"},
+				{"TEST-1", "This is a…", "Done"},
+				{"TEST-2", "Short [on…", "Open"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, fitColumns(data(), tc.maxWidth, tc.termWidth))
+		})
+	}
+}
+
+func TestColorStatusColumn(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	issues := []*jira.Issue{
+		{Fields: jira.IssueFields{Status: jira.IssueStatus{Name: "Open"}}},
+		{Fields: jira.IssueFields{Status: jira.IssueStatus{Name: "Done"}}},
+	}
+	issues[0].Fields.Status.StatusCategory.Key = jira.StatusCategoryToDo
+	issues[1].Fields.Status.StatusCategory.Key = jira.StatusCategoryDone
+
+	data := tui.TableData{
+		{"KEY", "STATUS"},
+		{"TEST-1", "Open"},
+		{"TEST-2", "Done"},
+	}
+	expected := tui.TableData{
+		{"KEY", "\x1b[39mSTATUS\x1b[0m"},
+		{"TEST-1", "\x1b[34mOpen\x1b[0m"},
+		{"TEST-2", "\x1b[32mDone\x1b[0m"},
+	}
+	assert.Equal(t, expected, colorStatusColumn(data, 1, true, issues))
+
+	colorFn := statusColorFunc(tui.TableData{{"KEY", "STATUS"}}, issues)
+	assert.Equal(t, tcell.ColorGreen, colorFn(2, 1, "Done"))
+	assert.Equal(t, tcell.ColorDefault, colorFn(2, 1, "Unknown"))
+	assert.Equal(t, tcell.ColorDefault, colorFn(2, 0, "TEST-2"))
+
+	color.NoColor = true
+	assert.Equal(t, tcell.ColorDefault, colorFn(2, 1, "Done"))
+}
+
+func TestIssueColumnFields(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, IssueColumnFields([]string{"key", "unknown"}))
+	assert.Equal(t,
+		[]string{"summary", "assignee", "issuetype"},
+		IssueColumnFields([]string{"key", "summary", " Assignee", "TYPE"}),
+	)
+}
+
+func TestFormatSize(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0 B", formatSize(0))
+	assert.Equal(t, "1023 B", formatSize(1023))
+	assert.Equal(t, "1.0 KB", formatSize(1024))
+	assert.Equal(t, "1.5 MB", formatSize(1536*1024))
+	assert.Equal(t, "2.0 GB", formatSize(2*1024*1024*1024))
+	assert.Equal(t, "3.0 PB", formatSize(3<<50))
+	assert.Equal(t, "8.0 EB", formatSize(math.MaxInt64))
+}
+
 func TestMax(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 
@@ -40,9 +41,18 @@ type issueComment struct {
 	body string
 }
 
+// Comment orders supported by IssueOption.CommentsOrder.
+const (
+	CommentsOrderAsc  = "asc"
+	CommentsOrderDesc = "desc"
+)
+
 // IssueOption is filtering options for an issue.
 type IssueOption struct {
 	NumComments uint
+	// CommentsOrder is the order in which the last NumComments comments
+	// are shown, newest first (desc) by default.
+	CommentsOrder string
 }
 
 // Issue is a list view for issues.
@@ -109,6 +119,15 @@ func (i Issue) String() string {
 	if len(i.Data.Fields.IssueLinks) > 0 {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("Linked Issues"), i.linkedIssues()))
 	}
+	if len(i.Data.Fields.Attachments) > 0 {
+		s.WriteString(
+			fmt.Sprintf(
+				"\n\n%s\n\n%s\n",
+				i.separator(fmt.Sprintf("%d Attachments", len(i.Data.Fields.Attachments))),
+				i.attachments(),
+			),
+		)
+	}
 	total := i.Data.Fields.Comment.Total
 	if total > 0 && i.Options.NumComments > 0 {
 		sep := fmt.Sprintf("%d Comments", total)
@@ -160,6 +179,17 @@ func (i Issue) fragments() []fragment {
 		)
 	}
 
+	if len(i.Data.Fields.Attachments) > 0 {
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: i.separator(fmt.Sprintf("%d Attachments", len(i.Data.Fields.Attachments)))},
+			newBlankFragment(2),
+			fragment{Body: i.attachments()},
+			newBlankFragment(1),
+		)
+	}
+
 	if i.Data.Fields.Comment.Total > 0 && i.Options.NumComments > 0 {
 		scraps = append(
 			scraps,
@@ -205,9 +235,10 @@ func (i Issue) header() string {
 		as = "Unassigned"
 	}
 	st, sti := i.Data.Fields.Status.Name, "🚧"
-	if st == "Done" {
+	if st == "Done" || i.Data.Fields.Status.StatusCategory.Key == jira.StatusCategoryDone {
 		sti = "✅"
 	}
+	st = coloredStatus(i.Data.Fields.Status, st)
 	lbl := "None"
 	if len(i.Data.Fields.Labels) > 0 {
 		lbl = strings.Join(i.Data.Fields.Labels, ", ")
@@ -231,12 +262,33 @@ func (i Issue) header() string {
 		wch = fmt.Sprintf("You + %d watchers", i.Data.Fields.Watches.WatchCount-1)
 	}
 	return fmt.Sprintf(
-		"%s %s  %s %s  ⌛ %s  👷 %s  🔑️ %s  💭 %d comments  \U0001F9F5 %d linked\n# %s\n⏱️  %s  🔎 %s  🚀 %s  📦 %s  🏷️  %s  👀 %s",
-		iti, it, sti, st, cmdutil.FormatDateTimeHuman(i.Data.Fields.Updated, jira.RFC3339), as, i.Data.Key,
+		"%s %s  %s %s  ⌛ %s  👷 %s  🔑️ %s  💭 %d comments  \U0001F9F5 %d linked\n# %s\n⏱️  %s  🔎 %s  🚀 %s  📦 %s  🏷️  %s  👀 %s%s",
+		iti, it, sti, st, i.Display.date(i.Data.Fields.Updated, jira.RFC3339), as, i.Data.Key,
 		i.Data.Fields.Comment.Total, len(i.Data.Fields.IssueLinks),
 		i.Data.Fields.Summary,
-		cmdutil.FormatDateTimeHuman(i.Data.Fields.Created, jira.RFC3339), i.Data.Fields.Reporter.Name,
-		i.Data.Fields.Priority.Name, cmpt, lbl, wch,
+		i.Display.date(i.Data.Fields.Created, jira.RFC3339), i.Data.Fields.Reporter.Name,
+		i.Data.Fields.Priority.Name, cmpt, lbl, wch, i.timeTracking(),
+	)
+}
+
+// timeTracking returns time spent, remaining and original estimate
+// of the issue in a new line or an empty string if time is not tracked.
+func (i Issue) timeTracking() string {
+	f := i.Data.Fields
+	if f.TimeSpent == 0 && f.TimeEstimate == 0 && f.TimeOriginalEstimate == 0 {
+		return ""
+	}
+
+	format := func(seconds int) string {
+		if seconds == 0 {
+			return "-"
+		}
+		return jira.FormatJiraDuration(seconds)
+	}
+
+	return fmt.Sprintf(
+		"\n⏳ %s spent  %s remaining  %s estimated",
+		format(f.TimeSpent), format(f.TimeEstimate), format(f.TimeOriginalEstimate),
 	)
 }
 
@@ -247,11 +299,13 @@ func (i Issue) description() string {
 
 	var desc string
 
-	if adfNode, ok := i.Data.Fields.Description.(*adf.ADF); ok {
-		desc = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
-	} else {
-		desc = i.Data.Fields.Description.(string)
-		desc = md.FromJiraMD(desc)
+	switch v := i.Data.Fields.Description.(type) {
+	case *adf.ADF:
+		desc = adf.NewTranslator(v, adf.NewMarkdownTranslator()).Translate()
+	case string:
+		desc = md.FromJiraMD(v)
+	default:
+		desc = fmt.Sprintf("%v", v)
 	}
 
 	return desc
@@ -295,7 +349,7 @@ func (i Issue) subtasks() string {
 				coloredOut(pad(task.Key, maxKeyLen), color.FgGreen, color.Bold),
 				shortenAndPad(task.Fields.Summary, summaryLen),
 				pad(task.Fields.Priority.Name, maxPriorityLen),
-				pad(task.Fields.Status.Name, maxStatusLen),
+				coloredStatus(task.Fields.Status, pad(task.Fields.Status.Name, maxStatusLen)),
 			),
 		)
 	}
@@ -369,7 +423,7 @@ func (i Issue) linkedIssues() string {
 					shortenAndPad(iss.Fields.Summary, summaryLen),
 					pad(iss.Fields.IssueType.Name, maxTypeLen),
 					pad(iss.Fields.Priority.Name, maxPriorityLen),
-					pad(iss.Fields.Status.Name, maxStatusLen),
+					coloredStatus(iss.Fields.Status, pad(iss.Fields.Status.Name, maxStatusLen)),
 				),
 			)
 		}
@@ -378,6 +432,50 @@ func (i Issue) linkedIssues() string {
 	return linked.String()
 }
 
+func (i Issue) attachments() string {
+	if len(i.Data.Fields.Attachments) == 0 {
+		return ""
+	}
+
+	var (
+		attachments   strings.Builder
+		maxNameLen    int
+		maxSizeLen    int
+		maxMimeLen    int
+		maxAuthorLen  int
+		formattedSize = make([]string, 0, len(i.Data.Fields.Attachments))
+	)
+
+	for _, a := range i.Data.Fields.Attachments {
+		size := formatSize(a.Size)
+		formattedSize = append(formattedSize, size)
+
+		maxNameLen = max(len(a.Filename), maxNameLen)
+		maxSizeLen = max(len(size), maxSizeLen)
+		maxMimeLen = max(len(a.MimeType), maxMimeLen)
+		maxAuthorLen = max(len(a.Author.DisplayName), maxAuthorLen)
+	}
+
+	attachments.WriteString(
+		fmt.Sprintf("\n %s\n\n", coloredOut("ATTACHMENTS", color.FgWhite, color.Bold)),
+	)
+	for idx, a := range i.Data.Fields.Attachments {
+		attachments.WriteString(
+			fmt.Sprintf(
+				"  %s • %s • %s • %s • %s\n    %s\n",
+				coloredOut(pad(a.Filename, maxNameLen), color.FgGreen, color.Bold),
+				pad(formattedSize[idx], maxSizeLen),
+				pad(a.MimeType, maxMimeLen),
+				pad(a.Author.DisplayName, maxAuthorLen),
+				i.Display.date(a.Created, jira.RFC3339),
+				a.Content,
+			),
+		)
+	}
+
+	return attachments.String()
+}
+
 func (i Issue) comments() []issueComment {
 	total := i.Data.Fields.Comment.Total
 	comments := make([]issueComment, 0, total)
@@ -388,14 +486,24 @@ func (i Issue) comments() []issueComment {
 
 	limit := min(int(i.Options.NumComments), total)
 
+	indices := make([]int, 0, limit)
 	for idx := total - 1; idx >= total-limit; idx-- {
+		indices = append(indices, idx)
+	}
+	if i.Options.CommentsOrder == CommentsOrderAsc {
+		slices.Reverse(indices)
+	}
+
+	for _, idx := range indices {
 		c := i.Data.Fields.Comment.Comments[idx]
 		var body string
-		if adfNode, ok := c.Body.(*adf.ADF); ok {
-			body = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
-		} else {
-			body = c.Body.(string)
-			body = md.FromJiraMD(body)
+		switch v := c.Body.(type) {
+		case *adf.ADF:
+			body = adf.NewTranslator(v, adf.NewMarkdownTranslator()).Translate()
+		case string:
+			body = md.FromJiraMD(v)
+		default:
+			body = fmt.Sprintf("%v", v)
 		}
 		authorName := func() string {
 			if c.Author.DisplayName != "" {
@@ -406,7 +514,7 @@ func (i Issue) comments() []issueComment {
 		meta := fmt.Sprintf(
 			"\n %s • %s",
 			coloredOut(authorName(), color.FgWhite, color.Bold),
-			coloredOut(cmdutil.FormatDateTimeHuman(c.Created, jira.RFC3339), color.FgWhite, color.Bold),
+			coloredOut(i.Display.date(c.Created, jira.RFC3339), color.FgWhite, color.Bold),
 		)
 		if idx == total-1 {
 			meta += fmt.Sprintf(" • %s", coloredOut("Latest comment", color.FgCyan, color.Bold))
@@ -2,6 +2,7 @@ package view
 
 import (
 	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -45,9 +46,7 @@ func TestIssueDetailsRenderInPlainView(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Status: jira.IssueStatus{Name: "Done"},
 			Components: []struct {
 				Name string `json:"name"`
 			}{{Name: "BE"}, {Name: "FE"}},
@@ -110,9 +109,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Status: jira.IssueStatus{Name: "Done"},
 			Components: []struct {
 				Name string `json:"name"`
 			}{{Name: "BE"}, {Name: "FE"}},
@@ -142,9 +139,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 					Key: "TEST-2",
 					Fields: jira.IssueFields{
 						Summary: "Subtask 1",
-						Status: struct {
-							Name string `json:"name"`
-						}{Name: "TO DO"},
+						Status:  jira.IssueStatus{Name: "TO DO"},
 						Priority: struct {
 							Name string `json:"name"`
 						}{Name: "High"},
@@ -154,9 +149,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 					Key: "TEST-3",
 					Fields: jira.IssueFields{
 						Summary: "Subtask 2",
-						Status: struct {
-							Name string `json:"name"`
-						}{Name: "Done"},
+						Status:  jira.IssueStatus{Name: "Done"},
 						Priority: struct {
 							Name string `json:"name"`
 						}{Name: "Normal"},
@@ -186,9 +179,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 							IssueType: jira.IssueType{Name: "Bug"},
 							Priority: struct {
 								Name string `json:"name"`
-							}{Name: "High"}, Status: struct {
-								Name string `json:"name"`
-							}{Name: "TO DO"},
+							}{Name: "High"}, Status: jira.IssueStatus{Name: "TO DO"},
 						},
 					},
 				},
@@ -205,9 +196,7 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 							IssueType: jira.IssueType{Name: "Bug"},
 							Priority: struct {
 								Name string `json:"name"`
-							}{Name: "Urgent"}, Status: struct {
-								Name string `json:"name"`
-							}{Name: "Done"},
+							}{Name: "Urgent"}, Status: jira.IssueStatus{Name: "Done"},
 						},
 					},
 				},
@@ -238,6 +227,17 @@ func TestIssueDetailsWithV2Description(t *testing.T) {
 	assert.Equal(t, tui.TextData(expected), tui.TextData(actual))
 }
 
+func TestIssueTimeTracking(t *testing.T) {
+	t.Parallel()
+
+	issue := Issue{Data: &jira.Issue{Key: "TEST-1"}}
+	assert.Equal(t, "", issue.timeTracking())
+
+	issue.Data.Fields.TimeSpent = (3*8 + 2) * 60 * 60
+	issue.Data.Fields.TimeOriginalEstimate = 5 * 8 * 60 * 60
+	assert.Equal(t, "\n⏳ 3d 2h spent  - remaining  1w estimated", issue.timeTracking())
+}
+
 func TestSeparator(t *testing.T) {
 	t.Parallel()
 
@@ -310,3 +310,79 @@ func TestSeparator(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueAttachments(t *testing.T) {
+	t.Parallel()
+
+	issue := Issue{Data: &jira.Issue{Key: "TEST-1"}}
+	assert.Equal(t, "", issue.attachments())
+
+	issue.Data.Fields.Attachments = []jira.Attachment{
+		{
+			Filename: "screenshot.png",
+			Author:   jira.User{DisplayName: "Person A"},
+			Created:  "2020-12-13T14:05:20.974+0100",
+			Size:     153600,
+			MimeType: "image/png",
+			Content:  "https://test.local/attachment/10001",
+		},
+		{
+			Filename: "log.txt",
+			Author:   jira.User{DisplayName: "Person B"},
+			Created:  "2020-12-14T14:05:20.974+0100",
+			Size:     512,
+			MimeType: "text/plain",
+			Content:  "https://test.local/attachment/10002",
+		},
+	}
+
+	expected := "\n ATTACHMENTS\n\n" +
+		"  screenshot.png • 150.0 KB • image/png  • Person A • Sun, 13 Dec 20\n    https://test.local/attachment/10001\n" +
+		"  log.txt        • 512 B    • text/plain • Person B • Mon, 14 Dec 20\n    https://test.local/attachment/10002\n"
+	assert.Equal(t, expected, issue.attachments())
+}
+
+func TestIssueCommentsOrder(t *testing.T) {
+	t.Parallel()
+
+	data := &jira.Issue{Key: "TEST-1"}
+	data.Fields.Comment.Comments = []struct {
+		ID      string      `json:"id"`
+		Author  jira.User   `json:"author"`
+		Body    interface{} `json:"body"`
+		Created string      `json:"created"`
+	}{
+		{ID: "10033", Author: jira.User{Name: "Person A"}, Body: "Test comment A", Created: "2021-11-22T23:44:13.782+0100"},
+		{ID: "10034", Author: jira.User{Name: "Person B"}, Body: "Test comment B", Created: "2021-11-23T23:44:13.782+0100"},
+		{ID: "10035", Author: jira.User{Name: "Person C"}, Body: "Test comment C", Created: "2021-11-24T23:44:13.782+0100"},
+	}
+	data.Fields.Comment.Total = 3
+
+	cases := []struct {
+		name     string
+		order    string
+		expected []string
+	}{
+		{name: "default order is newest first", order: "", expected: []string{"Test comment C", "Test comment B"}},
+		{name: "desc", order: CommentsOrderDesc, expected: []string{"Test comment C", "Test comment B"}},
+		{name: "asc", order: CommentsOrderAsc, expected: []string{"Test comment B", "Test comment C"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			issue := Issue{
+				Data:    data,
+				Display: DisplayFormat{Plain: true},
+				Options: IssueOption{NumComments: 2, CommentsOrder: tc.order},
+			}
+
+			comments := issue.comments()
+			assert.Len(t, comments, len(tc.expected))
+			for i, body := range tc.expected {
+				assert.Equal(t, body, comments[i].body)
+			}
+			// The latest comment is always marked, irrespective of the order.
+			assert.Contains(t, comments[slices.Index(tc.expected, "Test comment C")].meta, "Latest comment")
+		})
+	}
+}
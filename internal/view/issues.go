@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"text/tabwriter"
 
@@ -20,11 +21,53 @@ type DisplayFormat struct {
 	CSV          bool
 	NoHeaders    bool
 	NoTruncate   bool
+	MaxColWidth  uint
 	Columns      []string
 	FixedColumns uint
 	Comments     uint
 	TableStyle   tui.TableStyle
 	Timezone     string
+	DateFormat   string
+}
+
+// fit truncates long values in the plain view. Values are not truncated with --no-truncate,
+// otherwise the columns are shrunk to fit in the terminal if the output is a terminal.
+func (d DisplayFormat) fit(data tui.TableData, delimiter string) tui.TableData {
+	if d.NoTruncate {
+		return data
+	}
+
+	var termWidth int
+	if delimiter == "\t" && !tui.IsNotTTY() {
+		termWidth = tui.TerminalWidth()
+	}
+	return fitColumns(data, int(d.MaxColWidth), termWidth)
+}
+
+// DateFormatRelative displays dates relative to the current time, eg: 3 days ago.
+const DateFormatRelative = "relative"
+
+const (
+	dateTimeLayout = "2006-01-02 15:04:05"
+	dateLayout     = "Mon, 02 Jan 06"
+)
+
+// dateTime formats datetime for tabular views.
+func (d DisplayFormat) dateTime(dt, format string) string {
+	return d.formatDate(dt, format, dateTimeLayout)
+}
+
+// date formats datetime for detailed views where only the date is displayed by default.
+func (d DisplayFormat) date(dt, format string) string {
+	return d.formatDate(dt, format, dateLayout)
+}
+
+func (d DisplayFormat) formatDate(dt, format, fallback string) string {
+	layout := d.DateFormat
+	if layout == "" {
+		layout = fallback
+	}
+	return formatDateTimeLayout(dt, format, d.Timezone, layout)
 }
 
 // IssueList is a list view for issues.
@@ -130,6 +173,8 @@ func (l *IssueList) Render() error {
 		}),
 		tui.WithRefreshFunc(l.Refresh),
 		tui.WithFixedColumns(l.Display.FixedColumns),
+		tui.WithMaxColWidth(l.Display.MaxColWidth),
+		tui.WithCellColorFunc(statusColorFunc(data, l.Data)),
 	)
 
 	return view.Paint(data)
@@ -137,7 +182,11 @@ func (l *IssueList) Render() error {
 
 // renderPlain renders the issue in plain view.
 func (l *IssueList) renderPlain(w io.Writer, delimeter string) error {
-	return renderPlain(w, l.data(), delimeter)
+	data := l.Display.fit(l.data(), delimeter)
+	hasHeader := len(data) > len(l.Data)
+	data = colorStatusColumn(data, slices.Index(l.header(), fieldStatus), hasHeader, l.Data)
+
+	return renderPlain(w, data, delimeter)
 }
 
 // renderCSV renders issues in csv format.
@@ -226,9 +275,9 @@ func (l *IssueList) assignColumns(columns []string, issue *jira.Issue) []string
 		case fieldResolution:
 			bucket = append(bucket, issue.Fields.Resolution.Name)
 		case fieldCreated:
-			bucket = append(bucket, formatDateTime(issue.Fields.Created, jira.RFC3339, l.Display.Timezone))
+			bucket = append(bucket, l.Display.dateTime(issue.Fields.Created, jira.RFC3339))
 		case fieldUpdated:
-			bucket = append(bucket, formatDateTime(issue.Fields.Updated, jira.RFC3339, l.Display.Timezone))
+			bucket = append(bucket, l.Display.dateTime(issue.Fields.Updated, jira.RFC3339))
 		case fieldLabels:
 			bucket = append(bucket, strings.Join(issue.Fields.Labels, ","))
 		}
@@ -107,6 +107,29 @@ Story	TEST-2	This is another test	Open		Person A	Normal		2020-12-13 14:05:20	202
 	assert.Equal(t, expected, b.String())
 }
 
+func TestIssueRenderInPlainViewWithMaxColWidth(t *testing.T) {
+	var b bytes.Buffer
+
+	issue := IssueList{
+		Total:   2,
+		Project: "TEST",
+		Server:  "https://test.local",
+		Data:    getIssues(),
+		Display: DisplayFormat{
+			Plain:       true,
+			Columns:     []string{"key", "summary"},
+			MaxColWidth: 10,
+		},
+	}
+	assert.NoError(t, issue.renderPlain(&b, "|"))
+
+	expected := `KEY|SUMMARY
+TEST-1|This is a…
+TEST-2|This is a…
+`
+	assert.Equal(t, expected, b.String())
+}
+
 func TestIssueRenderInPlainViewWithoutHeaders(t *testing.T) {
 	var b bytes.Buffer
 
@@ -173,9 +196,7 @@ func getIssues() []*jira.Issue {
 				Reporter: struct {
 					Name string `json:"displayName"`
 				}{Name: "Person Z"},
-				Status: struct {
-					Name string `json:"name"`
-				}{Name: "Done"},
+				Status:  jira.IssueStatus{Name: "Done"},
 				Created: "2020-12-13T14:05:20.974+0100",
 				Updated: "2020-12-13T14:07:20.974+0100",
 				Labels:  []string{"krakatit"},
@@ -192,9 +213,7 @@ func getIssues() []*jira.Issue {
 				Reporter: struct {
 					Name string `json:"displayName"`
 				}{Name: "Person A"},
-				Status: struct {
-					Name string `json:"name"`
-				}{Name: "Open"},
+				Status:  jira.IssueStatus{Name: "Open"},
 				Created: "2020-12-13T14:05:20.974+0100",
 				Updated: "2020-12-13T14:07:20.974+0100",
 				Labels:  []string{"pat", "mat"},
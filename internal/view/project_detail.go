@@ -0,0 +1,110 @@
+package view
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ProjectDetailOption is a functional option to wrap project detail properties.
+type ProjectDetailOption func(*ProjectDetail)
+
+// ProjectDetail is a project detail view.
+type ProjectDetail struct {
+	data   *jira.Project
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewProjectDetail initializes a project detail view.
+func NewProjectDetail(data *jira.Project, opts ...ProjectDetailOption) *ProjectDetail {
+	p := ProjectDetail{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	p.writer = tabwriter.NewWriter(p.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return &p
+}
+
+// WithProjectDetailWriter sets a writer for the project detail view.
+func WithProjectDetailWriter(w io.Writer) ProjectDetailOption {
+	return func(p *ProjectDetail) {
+		p.writer = w
+	}
+}
+
+// Render renders the project detail view.
+func (p ProjectDetail) Render() error {
+	d := p.data
+
+	_, _ = fmt.Fprintf(p.writer, "%s (%s)\n\n", d.Name, d.Key)
+	_, _ = fmt.Fprintf(p.writer, "Type:\t%s\n", cmp.Or(d.ProjectTypeKey, d.Type))
+	_, _ = fmt.Fprintf(p.writer, "Lead:\t%s\n", cmp.Or(d.Lead.Name, "-"))
+	if d.Description != "" {
+		_, _ = fmt.Fprintf(p.writer, "Description:\t%s\n", d.Description)
+	}
+
+	p.section("ISSUE TYPES", len(d.IssueTypes), func() {
+		for _, t := range d.IssueTypes {
+			kind := "standard"
+			if t.Subtask {
+				kind = "subtask"
+			}
+			_, _ = fmt.Fprintf(p.writer, "%s\t%s\n", t.Name, kind)
+		}
+	})
+	p.section("COMPONENTS", len(d.Components), func() {
+		for _, c := range d.Components {
+			_, _ = fmt.Fprintf(p.writer, "%s\t%s\n", c.Name, cmp.Or(c.Lead.Name, "-"))
+		}
+	})
+	p.section("VERSIONS", len(d.Versions), func() {
+		for _, v := range d.Versions {
+			status := "unreleased"
+			switch {
+			case v.Archived:
+				status = "archived"
+			case v.Released:
+				status = "released"
+			}
+			_, _ = fmt.Fprintf(p.writer, "%s\t%s\t%s\n", v.Name, status, cmp.Or(v.ReleaseDate, "-"))
+		}
+	})
+
+	roles := make([]string, 0, len(d.Roles))
+	for r := range d.Roles {
+		roles = append(roles, r)
+	}
+	slices.Sort(roles)
+	p.section("ROLES", len(roles), func() {
+		_, _ = fmt.Fprintln(p.writer, strings.Join(roles, ", "))
+	})
+
+	if _, ok := p.writer.(*tabwriter.Writer); ok {
+		err := p.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(p.buf.String())
+}
+
+func (p ProjectDetail) section(title string, n int, fn func()) {
+	if n == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(p.writer, "\n%s\n%s\n", title, strings.Repeat("-", len(title)))
+	fn()
+}
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestProjectDetailRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := &jira.Project{
+		Key:            "PRJ",
+		Name:           "Project",
+		Description:    "Main project",
+		ProjectTypeKey: "software",
+		IssueTypes: []*jira.IssueType{
+			{ID: "10001", Name: "Story"},
+			{ID: "10002", Name: "Sub-task", Subtask: true},
+		},
+		Components: []*jira.Component{{ID: "10100", Name: "Backend"}},
+		Versions: []*jira.ProjectVersion{
+			{ID: "10200", Name: "v1.0", Released: true, ReleaseDate: "2024-01-31"},
+			{ID: "10201", Name: "v1.1"},
+		},
+		Roles: map[string]string{"Developers": "", "Administrators": ""},
+	}
+	data.Lead.Name = "Person A"
+
+	project := NewProjectDetail(data, WithProjectDetailWriter(&b))
+	assert.NoError(t, project.Render())
+
+	expected := `Project (PRJ)
+
+Type:	software
+Lead:	Person A
+Description:	Main project
+
+ISSUE TYPES
+-----------
+Story	standard
+Sub-task	subtask
+
+COMPONENTS
+----------
+Backend	-
+
+VERSIONS
+--------
+v1.0	released	2024-01-31
+v1.1	unreleased	-
+
+ROLES
+-----
+Administrators, Developers
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,94 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// RemoteLinkOption is a functional option to wrap remote link properties.
+type RemoteLinkOption func(*RemoteLink)
+
+// RemoteLink is an issue remote link view.
+type RemoteLink struct {
+	data   []*jira.RemoteLink
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewRemoteLink initializes an issue remote link view.
+func NewRemoteLink(data []*jira.RemoteLink, opts ...RemoteLinkOption) *RemoteLink {
+	r := RemoteLink{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	r.writer = tabwriter.NewWriter(r.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// WithRemoteLinkWriter sets a writer for the remote link view.
+func WithRemoteLinkWriter(w io.Writer) RemoteLinkOption {
+	return func(r *RemoteLink) {
+		r.writer = w
+	}
+}
+
+// Render renders the issue remote link view.
+func (r RemoteLink) Render() error {
+	r.printHeader()
+
+	for _, d := range r.data {
+		var app, resolved string
+		if d.Application != nil {
+			app = d.Application.Name
+		}
+		if d.Object.Status != nil {
+			resolved = strconv.FormatBool(d.Object.Status.Resolved)
+		}
+
+		_, _ = fmt.Fprintf(
+			r.writer, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			d.ID, prepareTitle(d.Object.Title), d.Object.URL, d.Relationship, app, resolved,
+		)
+	}
+	if _, ok := r.writer.(*tabwriter.Writer); ok {
+		err := r.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(r.buf.String())
+}
+
+func (r RemoteLink) header() []string {
+	return []string{
+		"ID",
+		"TITLE",
+		"URL",
+		"RELATIONSHIP",
+		"APPLICATION",
+		"RESOLVED",
+	}
+}
+
+func (r RemoteLink) printHeader() {
+	headers := r.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(r.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(r.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(r.writer)
+}
@@ -0,0 +1,39 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestRemoteLinkRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.RemoteLink{
+		{
+			ID:           10000,
+			Application:  &jira.RemoteLinkApplication{Type: "com.github", Name: "GitHub"},
+			Relationship: "implemented by",
+			Object: jira.RemoteLinkObject{
+				URL:    "https://github.com/org/repo/pull/1",
+				Title:  "PR #1",
+				Status: &jira.RemoteLinkStatus{Resolved: true},
+			},
+		},
+		{
+			ID:     10001,
+			Object: jira.RemoteLinkObject{URL: "https://weblink.com", Title: "weblink title"},
+		},
+	}
+	remoteLink := NewRemoteLink(data, WithRemoteLinkWriter(&b))
+	assert.NoError(t, remoteLink.Render())
+
+	expected := `ID	TITLE	URL	RELATIONSHIP	APPLICATION	RESOLVED
+10000	PR #1	https://github.com/org/repo/pull/1	implemented by	GitHub	true
+10001	weblink title	https://weblink.com			
+`
+	assert.Equal(t, expected, b.String())
+}
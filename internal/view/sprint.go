@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/ankitpokhrel/jira-cli/api"
-	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
@@ -49,6 +48,7 @@ func (sl *SprintList) Render() error {
 		tui.WithInitialText(helpText),
 		tui.WithContentTableOpts(
 			tui.WithFixedColumns(sl.Display.FixedColumns),
+			tui.WithMaxColWidth(sl.Display.MaxColWidth),
 			tui.WithTableStyle(sl.Display.TableStyle),
 			tui.WithSelectedFunc(navigate(sl.Server)),
 			tui.WithViewModeFunc(func(r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
@@ -86,6 +86,7 @@ func (sl *SprintList) RenderInTable() error {
 	data := sl.tableData()
 	view := tui.NewTable(
 		tui.WithFixedColumns(sl.Display.FixedColumns),
+		tui.WithMaxColWidth(sl.Display.MaxColWidth),
 		tui.WithTableStyle(sl.Display.TableStyle),
 		tui.WithTableFooterText(
 			fmt.Sprintf(
@@ -101,7 +102,7 @@ func (sl *SprintList) RenderInTable() error {
 // renderPlain renders the issue in plain view.
 func (sl *SprintList) renderPlain(w io.Writer) error {
 	// sprint view supports only \t as delimiter, not custom.
-	return renderPlain(w, sl.tableData(), "\t")
+	return renderPlain(w, sl.Display.fit(sl.tableData(), "\t"), "\t")
 }
 
 func (sl *SprintList) data() []tui.PreviewData {
@@ -123,8 +124,8 @@ func (sl *SprintList) data() []tui.PreviewData {
 				"➤ #%d %s: [%s - %s]",
 				s.ID,
 				prepareTitle(s.Name),
-				cmdutil.FormatDateTimeHuman(s.StartDate, time.RFC3339),
-				cmdutil.FormatDateTimeHuman(s.EndDate, time.RFC3339),
+				sl.Display.date(s.StartDate, time.RFC3339),
+				sl.Display.date(s.EndDate, time.RFC3339),
 			)),
 			Contents: func(key string) interface{} {
 				issues := sl.Issues(bid, sid)
@@ -150,8 +151,8 @@ func (sl *SprintList) tabularize(issues []*jira.Issue) tui.TableData {
 			issue.Fields.Reporter.Name,
 			issue.Fields.Priority.Name,
 			issue.Fields.Resolution.Name,
-			formatDateTime(issue.Fields.Created, jira.RFC3339, sl.Display.Timezone),
-			formatDateTime(issue.Fields.Updated, jira.RFC3339, sl.Display.Timezone),
+			sl.Display.dateTime(issue.Fields.Created, jira.RFC3339),
+			sl.Display.dateTime(issue.Fields.Updated, jira.RFC3339),
 			strings.Join(issue.Fields.Labels, ","),
 		})
 	}
@@ -215,11 +216,11 @@ func (sl *SprintList) assignColumns(columns []string, sprint *jira.Sprint) []str
 		case fieldName:
 			bucket = append(bucket, sprint.Name)
 		case fieldStartDate:
-			bucket = append(bucket, formatDateTime(sprint.StartDate, time.RFC3339, sl.Display.Timezone))
+			bucket = append(bucket, sl.Display.dateTime(sprint.StartDate, time.RFC3339))
 		case fieldEndDate:
-			bucket = append(bucket, formatDateTime(sprint.EndDate, time.RFC3339, sl.Display.Timezone))
+			bucket = append(bucket, sl.Display.dateTime(sprint.EndDate, time.RFC3339))
 		case fieldCompleteDate:
-			bucket = append(bucket, formatDateTime(sprint.CompleteDate, time.RFC3339, sl.Display.Timezone))
+			bucket = append(bucket, sl.Display.dateTime(sprint.CompleteDate, time.RFC3339))
 		case fieldState:
 			bucket = append(bucket, sprint.Status)
 		}
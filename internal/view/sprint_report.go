@@ -0,0 +1,116 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// SprintReportOption is a functional option to wrap sprint report properties.
+type SprintReportOption func(*SprintReport)
+
+// SprintReport is a sprint report view that summarizes
+// issue counts and points by status.
+type SprintReport struct {
+	issues []*jira.Issue
+	points map[string]float64
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewSprintReport initializes a sprint report view. Points column
+// is only displayed if points are set using WithSprintReportPoints.
+func NewSprintReport(issues []*jira.Issue, opts ...SprintReportOption) *SprintReport {
+	r := SprintReport{
+		issues: issues,
+		buf:    new(bytes.Buffer),
+	}
+	r.writer = tabwriter.NewWriter(r.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// WithSprintReportPoints sets points of each issue keyed by issue key.
+func WithSprintReportPoints(points map[string]float64) SprintReportOption {
+	return func(r *SprintReport) {
+		r.points = points
+	}
+}
+
+// WithSprintReportWriter sets a writer for the sprint report.
+func WithSprintReportWriter(w io.Writer) SprintReportOption {
+	return func(r *SprintReport) {
+		r.writer = w
+	}
+}
+
+// Render renders the sprint report view.
+func (r SprintReport) Render() error {
+	var (
+		statuses []string
+		counts   = make(map[string]int)
+		points   = make(map[string]float64)
+		total    float64
+	)
+
+	for _, iss := range r.issues {
+		status := iss.Fields.Status.Name
+		if _, ok := counts[status]; !ok {
+			statuses = append(statuses, status)
+		}
+		counts[status]++
+		points[status] += r.points[iss.Key]
+		total += r.points[iss.Key]
+	}
+
+	r.printHeader()
+	for _, status := range statuses {
+		r.printRow(status, counts[status], points[status])
+	}
+	r.printRow("TOTAL", len(r.issues), total)
+
+	if _, ok := r.writer.(*tabwriter.Writer); ok {
+		err := r.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(r.buf.String())
+}
+
+func (r SprintReport) printRow(status string, count int, points float64) {
+	if r.points == nil {
+		_, _ = fmt.Fprintf(r.writer, "%s\t%d\n", status, count)
+		return
+	}
+	_, _ = fmt.Fprintf(r.writer, "%s\t%d\t%s\n", status, count, strconv.FormatFloat(points, 'f', -1, 64))
+}
+
+func (r SprintReport) header() []string {
+	headers := []string{"STATUS", "ISSUES"}
+	if r.points != nil {
+		headers = append(headers, "POINTS")
+	}
+	return headers
+}
+
+func (r SprintReport) printHeader() {
+	headers := r.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(r.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(r.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(r.writer)
+}
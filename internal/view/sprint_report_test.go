@@ -0,0 +1,53 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getSprintReportIssues() []*jira.Issue {
+	issue := func(key, status string) *jira.Issue {
+		iss := &jira.Issue{Key: key}
+		iss.Fields.Status.Name = status
+		return iss
+	}
+	return []*jira.Issue{
+		issue("TEST-1", "To Do"),
+		issue("TEST-2", "Done"),
+		issue("TEST-3", "To Do"),
+	}
+}
+
+func TestSprintReportRender(t *testing.T) {
+	var b bytes.Buffer
+
+	report := NewSprintReport(getSprintReportIssues(), WithSprintReportWriter(&b))
+	assert.NoError(t, report.Render())
+
+	expected := `STATUS	ISSUES
+To Do	2
+Done	1
+TOTAL	3
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestSprintReportRenderWithPoints(t *testing.T) {
+	var b bytes.Buffer
+
+	points := map[string]float64{"TEST-1": 3, "TEST-2": 5, "TEST-3": 0.5}
+
+	report := NewSprintReport(getSprintReportIssues(), WithSprintReportPoints(points), WithSprintReportWriter(&b))
+	assert.NoError(t, report.Render())
+
+	expected := `STATUS	ISSUES	POINTS
+To Do	2	3.5
+Done	1	5
+TOTAL	3	8.5
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -45,9 +45,7 @@ func TestSprintPreviewLayoutData(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person Z"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Done"},
+			Status:  jira.IssueStatus{Name: "Done"},
 			Created: "2020-12-13T14:05:20.974+0100",
 			Updated: "2020-12-13T14:07:20.974+0100",
 			Labels:  []string{"urgent"},
@@ -64,9 +62,7 @@ func TestSprintPreviewLayoutData(t *testing.T) {
 			Reporter: struct {
 				Name string `json:"displayName"`
 			}{Name: "Person A"},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "Open"},
+			Status:  jira.IssueStatus{Name: "Open"},
 			Created: "2020-12-13T14:05:20.974+0100",
 			Updated: "2020-12-13T14:07:20.974+0100",
 			Labels:  []string{"blocked"},
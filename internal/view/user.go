@@ -0,0 +1,92 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// hiddenEmail is displayed when the email of a user is hidden by their privacy settings.
+const hiddenEmail = "(hidden)"
+
+// UserOption is a functional option to wrap user properties.
+type UserOption func(*User)
+
+// User is a user view.
+type User struct {
+	data   []*jira.User
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewUser initializes a user view.
+func NewUser(data []*jira.User, opts ...UserOption) *User {
+	u := User{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	u.writer = tabwriter.NewWriter(u.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&u)
+	}
+	return &u
+}
+
+// WithUserWriter sets a writer for the user view.
+func WithUserWriter(w io.Writer) UserOption {
+	return func(u *User) {
+		u.writer = w
+	}
+}
+
+// Render renders the user view.
+func (u User) Render() error {
+	u.printHeader()
+
+	for _, d := range u.data {
+		// Local installations identify users by name instead of account ID.
+		id := d.AccountID
+		if id == "" {
+			id = d.Name
+		}
+		email := d.Email
+		if email == "" {
+			email = hiddenEmail
+		}
+		_, _ = fmt.Fprintf(u.writer, "%s\t%s\t%s\t%t\n", id, prepareTitle(d.DisplayName), email, d.Active)
+	}
+	if _, ok := u.writer.(*tabwriter.Writer); ok {
+		err := u.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(u.buf.String())
+}
+
+func (u User) header() []string {
+	return []string{
+		"ID",
+		"NAME",
+		"EMAIL",
+		"ACTIVE",
+	}
+}
+
+func (u User) printHeader() {
+	headers := u.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		_, _ = fmt.Fprintf(u.writer, "%s", h)
+		if i != end {
+			_, _ = fmt.Fprintf(u.writer, "\t")
+		}
+	}
+	_, _ = fmt.Fprintln(u.writer)
+}
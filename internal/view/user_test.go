@@ -0,0 +1,29 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestUserRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.User{
+		{AccountID: "5fb82376aca10c006949f35b", DisplayName: "Jane Doe", Email: "jane@domain.tld", Active: true},
+		{AccountID: "5fb82376aca10c006949f35c", DisplayName: "Jon Doe", Active: false},
+		{Name: "person", DisplayName: "Person A", Email: "person@domain.tld", Active: true},
+	}
+	user := NewUser(data, WithUserWriter(&b))
+	assert.NoError(t, user.Render())
+
+	expected := `ID	NAME	EMAIL	ACTIVE
+5fb82376aca10c006949f35b	Jane Doe	jane@domain.tld	true
+5fb82376aca10c006949f35c	Jon Doe	(hidden)	false
+person	Person A	person@domain.tld	true
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -23,6 +23,7 @@ const (
 	NodeParagraph   = NodeType("paragraph")
 	NodeTable       = NodeType("table")
 	NodeMedia       = NodeType("media")
+	NodeRule        = NodeType("rule")
 
 	ChildNodeText        = NodeType("text")
 	ChildNodeListItem    = NodeType("listItem")
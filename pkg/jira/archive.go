@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ErrPremiumRequired is returned when the feature is only available in Jira cloud Premium or Enterprise plans.
+var ErrPremiumRequired = errors.New("jira: this feature is only available in Jira cloud Premium and Enterprise plans")
+
+type archiveRequest struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+}
+
+type archiveResponse struct {
+	Errors map[string]struct {
+		IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+		Message        string   `json:"message"`
+	} `json:"errors"`
+	NumberOfIssuesUpdated int `json:"numberOfIssuesUpdated"`
+}
+
+// ArchiveIssues archives issues using PUT /issue/archive endpoint. Issues that couldn't
+// be archived, eg: subtasks or issues that are already archived, are returned as an error.
+//
+// Archiving is only available in Premium and Enterprise plans, ErrPremiumRequired is
+// returned if the server rejects the request for that reason.
+func (c *Client) ArchiveIssues(keys []string) error {
+	body, err := json.Marshal(&archiveRequest{IssueIDsOrKeys: keys})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.Put(context.Background(), "/issue/archive", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden:
+		return premiumError(res)
+	default:
+		return formatUnexpectedResponse(res)
+	}
+
+	var out archiveResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return err
+	}
+
+	reasons := make([]string, 0, len(out.Errors))
+	for reason := range out.Errors {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+
+	var errs []error
+	for _, reason := range reasons {
+		e := out.Errors[reason]
+		errs = append(errs, fmt.Errorf("%s: %s", strings.Join(e.IssueIDsOrKeys, ", "), e.Message))
+	}
+	return errors.Join(errs...)
+}
+
+// RestoreIssue restores an archived issue using PUT /issue/{key}/restore endpoint.
+//
+// Restoring is only available in Premium and Enterprise plans, ErrPremiumRequired is
+// returned if the server rejects the request for that reason.
+func (c *Client) RestoreIssue(key string) error {
+	res, err := c.Put(context.Background(), fmt.Sprintf("/issue/%s/restore", key), nil, Header{
+		"Accept": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusForbidden:
+		return premiumError(res)
+	default:
+		return formatUnexpectedResponse(res)
+	}
+}
+
+// premiumError returns ErrPremiumRequired if the forbidden response says that the feature
+// isn't available in the plan of the instance. Other reasons, eg: missing permissions, are
+// returned as an unexpected response.
+func premiumError(res *http.Response) error {
+	e := formatUnexpectedResponse(res)
+	for _, msg := range append(e.Messages, e.Raw) {
+		if strings.Contains(strings.ToLower(msg), "premium") {
+			return ErrPremiumRequired
+		}
+	}
+	return e
+}
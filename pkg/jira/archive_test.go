@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveIssues(t *testing.T) {
+	var (
+		statusCode int
+		message    string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/archive", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		var req archiveRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3"}, req.IssueIDsOrKeys)
+
+		w.WriteHeader(statusCode)
+		switch statusCode {
+		case 200:
+			_, _ = w.Write([]byte(`{"errors":{"issueIsSubtask":{"count":1,"issueIdsOrKeys":["TEST-3"],"message":"Issue is a subtask."}},"numberOfIssuesUpdated":2}`))
+		case 403:
+			_, _ = w.Write([]byte(`{"errorMessages":["` + message + `"],"errors":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	statusCode = 200
+
+	err := client.ArchiveIssues([]string{"TEST-1", "TEST-2", "TEST-3"})
+	assert.EqualError(t, err, "TEST-3: Issue is a subtask.")
+
+	statusCode = 403
+	message = "Archiving issues is only available in Jira Premium and Enterprise plans."
+
+	err = client.ArchiveIssues([]string{"TEST-1", "TEST-2", "TEST-3"})
+	assert.ErrorIs(t, err, ErrPremiumRequired)
+
+	message = "You don't have permission to archive issues."
+
+	err = client.ArchiveIssues([]string{"TEST-1", "TEST-2", "TEST-3"})
+	assert.NotErrorIs(t, err, ErrPremiumRequired)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	statusCode = 400
+
+	err = client.ArchiveIssues([]string{"TEST-1", "TEST-2", "TEST-3"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestRestoreIssue(t *testing.T) {
+	var (
+		statusCode int
+		message    string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/restore", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		w.WriteHeader(statusCode)
+		if statusCode == 403 {
+			_, _ = w.Write([]byte(`{"errorMessages":["` + message + `"],"errors":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	statusCode = 204
+	assert.NoError(t, client.RestoreIssue("TEST-1"))
+
+	statusCode = 403
+	message = "Restoring issues is only available in Jira Premium and Enterprise plans."
+	assert.ErrorIs(t, client.RestoreIssue("TEST-1"), ErrPremiumRequired)
+
+	message = "You don't have permission to restore issues."
+	assert.NotErrorIs(t, client.RestoreIssue("TEST-1"), ErrPremiumRequired)
+
+	statusCode = 404
+	assert.Error(t, &ErrUnexpectedResponse{}, client.RestoreIssue("TEST-1"))
+}
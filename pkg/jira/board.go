@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 const (
@@ -38,6 +39,129 @@ func (c *Client) BoardSearch(project, name string) (*BoardResult, error) {
 	return c.board(path)
 }
 
+// GetBoards fetches all boards in a project, following pagination until every board is read.
+func (c *Client) GetBoards(project string) ([]*Board, error) {
+	var boards []*Board
+
+	for {
+		res, err := c.board(fmt.Sprintf("/board?projectKeyOrId=%s&startAt=%d", project, len(boards)))
+		if err != nil {
+			return nil, err
+		}
+		boards = append(boards, res.Boards...)
+
+		if len(res.Boards) == 0 || len(boards) >= res.Total {
+			break
+		}
+	}
+
+	return boards, nil
+}
+
+// GetBoard fetches a single board given an ID.
+func (c *Client) GetBoard(boardID int) (*Board, error) {
+	res, err := c.GetV1(context.Background(), fmt.Sprintf("/board/%d", boardID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Board
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// BoardConfiguration holds configuration of a board.
+type BoardConfiguration struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Ranking struct {
+		RankCustomFieldID int `json:"rankCustomFieldId"`
+	} `json:"ranking"`
+}
+
+// GetBoardConfiguration fetches configuration of the board, eg: the rank field the board
+// orders issues by, using GET /board/{boardId}/configuration endpoint.
+func (c *Client) GetBoardConfiguration(boardID int) (*BoardConfiguration, error) {
+	res, err := c.GetV1(context.Background(), fmt.Sprintf("/board/%d/configuration", boardID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out BoardConfiguration
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// BoardIssues fetches issues in the given board. Issues are ordered by rank unless
+// the jql, which is an optional additional filter, orders them otherwise.
+func (c *Client) BoardIssues(boardID int, jql string, from, limit uint) (*SearchResult, error) {
+	path := fmt.Sprintf("/board/%d/issue?startAt=%d&maxResults=%d", boardID, from, limit)
+	if jql != "" {
+		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
+	}
+
+	res, err := c.GetV1(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SearchResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetBoardIssues fetches all issues in the given board, following pagination
+// until every issue is read. The jql is an optional additional filter.
+func (c *Client) GetBoardIssues(boardID int, jql string) (*SearchResult, error) {
+	var out SearchResult
+
+	for {
+		res, err := c.BoardIssues(boardID, jql, uint(len(out.Issues)), sprintIssuesPageSize)
+		if err != nil {
+			return nil, err
+		}
+		out.Issues = append(out.Issues, res.Issues...)
+		out.Total = res.Total
+
+		if len(res.Issues) == 0 || len(out.Issues) >= res.Total {
+			break
+		}
+	}
+	out.MaxResults = len(out.Issues)
+
+	return &out, nil
+}
+
 func (c *Client) board(path string) (*BoardResult, error) {
 	res, err := c.GetV1(context.Background(), path, nil)
 	if err != nil {
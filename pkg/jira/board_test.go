@@ -81,3 +81,158 @@ func TestBoards(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetBoards(t *testing.T) {
+	var (
+		unexpectedStatusCode bool
+		requests             int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board", r.URL.Path)
+
+		requests++
+		qs := r.URL.Query()
+		assert.Equal(t, "TEST", qs.Get("projectKeyOrId"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch qs.Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"maxResults":1,"startAt":0,"total":2,"values":[{"id":1,"name":"Board 1","type":"scrum"}]}`))
+		case "1":
+			_, _ = w.Write([]byte(`{"maxResults":1,"startAt":1,"total":2,"values":[{"id":2,"name":"Board 2","type":"kanban"}]}`))
+		default:
+			t.Errorf("unexpected startAt %s", qs.Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoards("TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	expected := []*Board{
+		{ID: 1, Name: "Board 1", Type: "scrum"},
+		{ID: 2, Name: "Board 2", Type: "kanban"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoards("TEST")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetBoard(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":2,"name":"Board 2","type":"scrum","location":{"projectId":10000,"projectKey":"TEST"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoard(2)
+	assert.NoError(t, err)
+
+	expected := &Board{
+		ID:       2,
+		Name:     "Board 2",
+		Type:     "scrum",
+		Location: &BoardLocation{ProjectID: 10000, ProjectKey: "TEST"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoard(2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetBoardConfiguration(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2/configuration", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id":2,"name":"Board 2","ranking":{"rankCustomFieldId":10019}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoardConfiguration(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, actual.ID)
+	assert.Equal(t, "Board 2", actual.Name)
+	assert.Equal(t, 10019, actual.Ranking.RankCustomFieldID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoardConfiguration(2)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetBoardIssues(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2/issue", r.URL.Path)
+		assert.Equal(t, "ORDER BY Rank ASC", r.URL.Query().Get("jql"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		if r.URL.Query().Get("startAt") == "0" {
+			_, _ = w.Write([]byte(`{"total":3,"issues":[{"key":"TEST-3"},{"key":"TEST-1"}]}`))
+		} else {
+			assert.Equal(t, "2", r.URL.Query().Get("startAt"))
+			_, _ = w.Write([]byte(`{"total":3,"issues":[{"key":"TEST-2"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoardIssues(2, "ORDER BY Rank ASC")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, actual.Total)
+	assert.Len(t, actual.Issues, 3)
+	assert.Equal(t, "TEST-3", actual.Issues[0].Key)
+	assert.Equal(t, "TEST-2", actual.Issues[2].Key)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoardIssues(2, "ORDER BY Rank ASC")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,276 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// bulkConcurrency is the maximum number of requests sent
+// in parallel when running an operation on multiple issues.
+const bulkConcurrency = 5
+
+// BulkResult holds the result of an operation on a single issue
+// when running the operation on multiple issues.
+type BulkResult struct {
+	Key string
+	Err error
+}
+
+// TransitionOptions holds additional data for BulkTransition.
+type TransitionOptions struct {
+	// Comment is converted from markdown to Jira markup.
+	Comment string
+	// Assignee is the account id of the user for cloud installations, the username otherwise.
+	Assignee   string
+	Resolution string
+	// Installation is the type of the Jira installation, ie: Cloud or Local.
+	// Local installations fetch transitions using v2 API and do not verify
+	// if the transition is available.
+	Installation string
+}
+
+// BulkTransition moves issues to the state with the given transition name. Transitions
+// are resolved for each issue separately as ids may differ between workflows.
+// It continues on individual failures and returns result for each issue in the given order.
+func (c *Client) BulkTransition(ctx context.Context, keys []string, transitionName string, opts TransitionOptions) ([]BulkResult, error) {
+	if len(keys) == 0 || transitionName == "" {
+		return nil, fmt.Errorf("issue keys and transition name are required")
+	}
+
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.transitionByName(ctx, key, transitionName, &opts)
+	}), nil
+}
+
+func (c *Client) transitionByName(ctx context.Context, key, name string, opts *TransitionOptions) error {
+	var (
+		transitions []*Transition
+		err         error
+	)
+	if opts.Installation == InstallationTypeLocal {
+		transitions, err = c.TransitionsV2Ctx(ctx, key)
+	} else {
+		transitions, err = c.TransitionsCtx(ctx, key)
+	}
+	if err != nil {
+		return err
+	}
+
+	var tr *Transition
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, name) {
+			tr = t
+			break
+		}
+	}
+	if tr == nil {
+		return fmt.Errorf("invalid transition state %q", name)
+	}
+	if opts.Installation == InstallationTypeCloud && !tr.IsAvailable {
+		return fmt.Errorf("transition state %q is not available", name)
+	}
+
+	req := TransitionRequest{
+		Fields: &TransitionRequestFields{},
+		Update: &TransitionRequestUpdate{},
+		Transition: &TransitionRequestData{
+			ID:   tr.ID.String(),
+			Name: tr.Name,
+		},
+	}
+	if opts.Assignee != "" {
+		req.Fields.Assignee = &struct {
+			Name      string `json:"name,omitempty"`
+			AccountID string `json:"accountId,omitempty"`
+		}{}
+		if opts.Installation == InstallationTypeLocal {
+			req.Fields.Assignee.Name = opts.Assignee
+		} else {
+			req.Fields.Assignee.AccountID = opts.Assignee
+		}
+	}
+	if opts.Resolution != "" {
+		req.Fields.Resolution = &struct {
+			Name string `json:"name"`
+		}{Name: opts.Resolution}
+	}
+	if opts.Comment != "" {
+		req.Update.Comment = []struct {
+			Add struct {
+				Body string `json:"body"`
+			} `json:"add"`
+		}{
+			{Add: struct {
+				Body string `json:"body"`
+			}{Body: md.ToJiraMD(opts.Comment)}},
+		}
+	}
+
+	_, err = c.TransitionCtx(ctx, key, &req)
+	return err
+}
+
+// LinkPair is a single link operation for LinkIssues.
+type LinkPair struct {
+	InwardIssue  string
+	OutwardIssue string
+	// LinkType is the name of the link type or the relationship
+	// it describes, see ResolveIssueLinkType.
+	LinkType string
+	// Comment is optional, see LinkIssueWithComment.
+	Comment string
+}
+
+// LinkIssues applies multiple link operations using POST /issueLink endpoint.
+// It returns an error for each pair in the given order, nil if the pair was linked.
+//
+// Link types are resolved upfront so pairs with an invalid link type fail with
+// ErrInvalidLinkType without sending a request, other pairs are linked regardless.
+func (c *Client) LinkIssues(pairs []LinkPair) []error {
+	return c.LinkIssuesCtx(context.Background(), pairs)
+}
+
+// LinkIssuesCtx is the same as LinkIssues but uses the given context for the requests.
+func (c *Client) LinkIssuesCtx(ctx context.Context, pairs []LinkPair) []error {
+	out := make([]error, len(pairs))
+	if len(pairs) == 0 {
+		return out
+	}
+
+	types, err := c.GetIssueLinkTypes()
+	if err != nil {
+		for i := range out {
+			out[i] = err
+		}
+		return out
+	}
+
+	return runConcurrent(ctx, len(pairs), func(i int) error {
+		p := pairs[i]
+
+		lt, swap, err := ResolveIssueLinkType(types, p.LinkType)
+		if err != nil {
+			return err
+		}
+
+		inward, outward := p.InwardIssue, p.OutwardIssue
+		if swap {
+			inward, outward = outward, inward
+		}
+		return c.linkIssue(ctx, inward, outward, lt.Name, p.Comment)
+	})
+}
+
+// BulkComment adds the same comment to multiple issues. It continues on
+// individual failures and returns result for each issue in the given order.
+func (c *Client) BulkComment(ctx context.Context, keys []string, body string, opts *CommentOptions) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.AddIssueCommentWithOptions(ctx, key, body, opts)
+	})
+}
+
+// BulkAssign assigns multiple issues to the user using v3 version of the PUT /issue/{key}/assignee
+// endpoint. Assignee can also be AssigneeNone to unassign or AssigneeDefault to assign the default
+// assignee. It continues on individual failures and returns result for each issue in the given order.
+func (c *Client) BulkAssign(ctx context.Context, keys []string, assignee string) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.AssignIssueCtx(ctx, key, assignee)
+	})
+}
+
+// BulkAssignV2 is the same as BulkAssign but uses v2 version of the PUT /issue/{key}/assignee endpoint.
+func (c *Client) BulkAssignV2(ctx context.Context, keys []string, assignee string) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.AssignIssueV2Ctx(ctx, key, assignee)
+	})
+}
+
+// BulkWatch adds the user as a watcher of multiple issues using v3 version of the POST /issue/{key}/watchers
+// endpoint. It continues on individual failures and returns result for each issue in the given order.
+func (c *Client) BulkWatch(ctx context.Context, keys []string, watcher string) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.WatchIssueCtx(ctx, key, watcher)
+	})
+}
+
+// BulkWatchV2 is the same as BulkWatch but uses v2 version of the POST /issue/{key}/watchers endpoint.
+func (c *Client) BulkWatchV2(ctx context.Context, keys []string, watcher string) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.WatchIssueV2Ctx(ctx, key, watcher)
+	})
+}
+
+// BulkUnwatch removes the user from watchers of multiple issues using v3 version of the DELETE /issue/{key}/watchers
+// endpoint. It continues on individual failures and returns result for each issue in the given order.
+func (c *Client) BulkUnwatch(ctx context.Context, keys []string, watcher string) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.UnwatchIssueCtx(ctx, key, watcher)
+	})
+}
+
+// BulkUnwatchV2 is the same as BulkUnwatch but uses v2 version of the DELETE /issue/{key}/watchers endpoint.
+func (c *Client) BulkUnwatchV2(ctx context.Context, keys []string, watcher string) []BulkResult {
+	return c.runBulk(ctx, keys, func(key string) error {
+		return c.UnwatchIssueV2Ctx(ctx, key, watcher)
+	})
+}
+
+// runBulk runs fn for each key with bounded concurrency
+// and returns results in the order of the given keys.
+func (c *Client) runBulk(ctx context.Context, keys []string, fn func(key string) error) []BulkResult {
+	errs := runConcurrent(ctx, len(keys), func(i int) error {
+		return fn(keys[i])
+	})
+
+	out := make([]BulkResult, len(keys))
+	for i, key := range keys {
+		out[i] = BulkResult{Key: key, Err: errs[i]}
+	}
+	return out
+}
+
+// runConcurrent runs fn for indexes 0 to n-1 with bounded concurrency
+// and returns the errors in the order of the indexes.
+//
+// No new operations are started once the context is done, the
+// remaining operations are reported with the context error instead.
+// Progress is reported as operations complete, see WithProgress.
+func runConcurrent(ctx context.Context, n int, fn func(i int) error) []error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		done int
+		sem  = make(chan struct{}, bulkConcurrency)
+		out  = make([]error, n)
+	)
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			out[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			out[i] = fn(i)
+
+			mu.Lock()
+			done++
+			reportProgress(ctx, done, n)
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+
+	return out
+}
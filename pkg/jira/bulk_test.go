@@ -0,0 +1,273 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkTransition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/rest/api/3/issue/TEST-"):
+			if r.URL.Path == "/rest/api/3/issue/TEST-3/transitions" {
+				w.WriteHeader(404)
+				return
+			}
+
+			resp, err := os.ReadFile("./testdata/transitions.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		case r.Method == "POST":
+			assert.Contains(t, []string{"/rest/api/2/issue/TEST-1/transitions", "/rest/api/2/issue/TEST-2/transitions"}, r.URL.Path)
+
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			expectedBody := `{"update":{"comment":[{"add":{"body":"*Started*\n\n"}}]},"fields":{"assignee":{"accountId":"a12b3"}},` +
+				`"transition":{"id":"21","name":"In Progress"}}`
+			assert.JSONEq(t, expectedBody, actualBody.String())
+
+			w.WriteHeader(204)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err := client.BulkTransition(context.Background(), nil, "In Progress", TransitionOptions{})
+	assert.Error(t, err)
+
+	opts := TransitionOptions{Comment: "**Started**", Assignee: "a12b3", Installation: InstallationTypeCloud}
+
+	actual, err := client.BulkTransition(context.Background(), []string{"TEST-1", "TEST-2", "TEST-3"}, "in progress", opts)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 3)
+
+	assert.Equal(t, "TEST-1", actual[0].Key)
+	assert.NoError(t, actual[0].Err)
+	assert.Equal(t, "TEST-2", actual[1].Key)
+	assert.NoError(t, actual[1].Err)
+	assert.Equal(t, "TEST-3", actual[2].Key)
+	assert.Error(t, &ErrUnexpectedResponse{}, actual[2].Err)
+
+	actual, err = client.BulkTransition(context.Background(), []string{"TEST-1"}, "Done", opts)
+	assert.NoError(t, err)
+	assert.EqualError(t, actual[0].Err, `transition state "Done" is not available`)
+
+	actual, err = client.BulkTransition(context.Background(), []string{"TEST-1"}, "Unknown", opts)
+	assert.NoError(t, err)
+	assert.EqualError(t, actual[0].Err, `invalid transition state "Unknown"`)
+}
+
+func TestBulkComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"body":"heads up","properties":[{"key":"sd.public.comment","value":{"internal":false}}]}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/TEST-1/comment", "/rest/api/2/issue/TEST-3/comment":
+			w.WriteHeader(201)
+		case "/rest/api/2/issue/TEST-2/comment":
+			w.WriteHeader(404)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual := client.BulkComment(context.Background(), []string{"TEST-1", "TEST-2", "TEST-3"}, "heads up", &CommentOptions{})
+	assert.Len(t, actual, 3)
+
+	assert.Equal(t, "TEST-1", actual[0].Key)
+	assert.NoError(t, actual[0].Err)
+	assert.Equal(t, "TEST-2", actual[1].Key)
+	assert.Error(t, &ErrUnexpectedResponse{}, actual[1].Err)
+	assert.Equal(t, "TEST-3", actual[2].Key)
+	assert.NoError(t, actual[2].Err)
+}
+
+func TestBulkAssign(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		switch r.URL.Path {
+		case "/rest/api/3/issue/TEST-1/assignee", "/rest/api/3/issue/TEST-2/assignee":
+			assert.JSONEq(t, `{"accountId":"a12b3"}`, actualBody.String())
+			if r.URL.Path == "/rest/api/3/issue/TEST-2/assignee" {
+				w.WriteHeader(403)
+				return
+			}
+			w.WriteHeader(204)
+		case "/rest/api/2/issue/TEST-3/assignee":
+			assert.JSONEq(t, `{"name":"-1"}`, actualBody.String())
+			w.WriteHeader(204)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual := client.BulkAssign(context.Background(), []string{"TEST-1", "TEST-2"}, "a12b3")
+	assert.Len(t, actual, 2)
+	assert.NoError(t, actual[0].Err)
+	assert.Equal(t, "TEST-2", actual[1].Key)
+	assert.Error(t, &ErrUnexpectedResponse{}, actual[1].Err)
+
+	actual = client.BulkAssignV2(context.Background(), []string{"TEST-3"}, AssigneeNone)
+	assert.Len(t, actual, 1)
+	assert.NoError(t, actual[0].Err)
+}
+
+func TestBulkWatchAndUnwatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/rest/api/3/issue/TEST-1/watchers":
+			w.WriteHeader(204)
+		case r.Method == "DELETE" && r.URL.Path == "/rest/api/3/issue/TEST-1/watchers":
+			assert.Equal(t, "a12b3", r.URL.Query().Get("accountId"))
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual := client.BulkWatch(context.Background(), []string{"TEST-1", "TEST-2"}, "a12b3")
+	assert.Len(t, actual, 2)
+	assert.NoError(t, actual[0].Err)
+	assert.Error(t, &ErrUnexpectedResponse{}, actual[1].Err)
+
+	actual = client.BulkUnwatch(context.Background(), []string{"TEST-1", "TEST-2"}, "a12b3")
+	assert.Len(t, actual, 2)
+	assert.NoError(t, actual[0].Err)
+	assert.Error(t, &ErrUnexpectedResponse{}, actual[1].Err)
+}
+
+func TestBulkCanceledContext(t *testing.T) {
+	var called bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	actual := client.BulkWatch(ctx, []string{"TEST-1", "TEST-2"}, "a12b3")
+
+	assert.False(t, called)
+	assert.Len(t, actual, 2)
+	for _, r := range actual {
+		assert.ErrorIs(t, r.Err, context.Canceled)
+	}
+}
+
+func TestBulkProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	var reported []int
+
+	ctx := WithProgress(context.Background(), func(done, total int) {
+		assert.Equal(t, 3, total)
+		reported = append(reported, done)
+	})
+
+	actual := client.BulkWatch(ctx, []string{"TEST-1", "TEST-2", "TEST-3"}, "a12b3")
+	assert.Len(t, actual, 3)
+	assert.Equal(t, []int{1, 2, 3}, reported)
+}
+
+func TestLinkIssues(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		linked []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/issueLinkType":
+			resp, err := os.ReadFile("./testdata/issue-link-types.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		case "/rest/api/2/issueLink":
+			var req linkRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+			if req.OutwardIssue.Key == "TEST-404" {
+				w.WriteHeader(404)
+				return
+			}
+
+			mu.Lock()
+			linked = append(linked, fmt.Sprintf("%s %s %s", req.InwardIssue.Key, req.LinkType.Name, req.OutwardIssue.Key))
+			mu.Unlock()
+
+			w.WriteHeader(201)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual := client.LinkIssues([]LinkPair{
+		{InwardIssue: "TEST-1", OutwardIssue: "TEST-2", LinkType: "Blocks"},
+		{InwardIssue: "TEST-3", OutwardIssue: "TEST-4", LinkType: "depends on"},
+		{InwardIssue: "TEST-5", OutwardIssue: "TEST-6", LinkType: "is blocked by"},
+		{InwardIssue: "TEST-7", OutwardIssue: "TEST-404", LinkType: "Relates"},
+	})
+	assert.Len(t, actual, 4)
+	assert.NoError(t, actual[0])
+
+	var invalid *ErrInvalidLinkType
+	assert.ErrorAs(t, actual[1], &invalid)
+	assert.Equal(t, "depends on", invalid.LinkType)
+
+	assert.NoError(t, actual[2])
+	assert.ErrorIs(t, actual[3], ErrNotFound)
+
+	assert.ElementsMatch(t, []string{"TEST-1 Blocks TEST-2", "TEST-6 Blocks TEST-5"}, linked)
+}
@@ -2,17 +2,21 @@ package jira
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -33,6 +37,10 @@ const (
 
 	apiVersion2 = "v2"
 	apiVersion3 = "v3"
+
+	// APIVersionAuto makes the client route v3 calls to v2 version of the
+	// endpoints if the server is not a cloud installation, see Config.APIVersion.
+	APIVersionAuto = "auto"
 )
 
 var (
@@ -40,6 +48,18 @@ var (
 	ErrNoResult = fmt.Errorf("jira: no result")
 	// ErrEmptyResponse denotes empty response from the server.
 	ErrEmptyResponse = fmt.Errorf("jira: empty response from server")
+	// ErrBadRequest denotes 400 response from the server.
+	ErrBadRequest = fmt.Errorf("jira: bad request")
+	// ErrUnauthorized denotes 401 response from the server.
+	ErrUnauthorized = fmt.Errorf("jira: unauthorized")
+	// ErrForbidden denotes 403 response from the server.
+	ErrForbidden = fmt.Errorf("jira: forbidden")
+	// ErrNotFound denotes 404 response from the server.
+	ErrNotFound = fmt.Errorf("jira: not found")
+	// ErrNoAgileEndpoint denotes that the Jira Agile API endpoint is not configured.
+	ErrNoAgileEndpoint = fmt.Errorf("jira: agile endpoint not configured")
+	// ErrDryRun is returned instead of sending a mutating request in dry run mode.
+	ErrDryRun = fmt.Errorf("jira: dry run, request not sent")
 )
 
 // ErrUnexpectedResponse denotes response code other than the expected one.
@@ -47,10 +67,41 @@ type ErrUnexpectedResponse struct {
 	Body       Errors
 	Status     string
 	StatusCode int
+	// Messages holds general error messages from the response.
+	Messages []string
+	// FieldErrors holds errors for individual fields keyed by field name.
+	FieldErrors map[string]string
+	// Method and Path of the request that received the response.
+	Method string
+	Path   string
+	// Raw holds the response body if it isn't in the standard Jira error format.
+	Raw string
 }
 
 func (e *ErrUnexpectedResponse) Error() string {
-	return e.Body.String()
+	if out := e.Body.String(); out != "" {
+		return out
+	}
+	if e.Raw != "" {
+		return fmt.Sprintf("\nError:\n  - %s\n", e.Raw)
+	}
+	return ""
+}
+
+// Is reports whether the response matches the target sentinel error based on
+// the status code so that callers can use errors.Is(err, ErrNotFound) and alike.
+func (e *ErrUnexpectedResponse) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	}
+	return false
 }
 
 // ErrMultipleFailed represents a grouped error, usually when
@@ -105,25 +156,100 @@ type MTLSConfig struct {
 
 // Config is a jira config.
 type Config struct {
-	Server     string
-	Login      string
-	APIToken   string
-	AuthType   *AuthType
-	Insecure   *bool
-	Debug      bool
-	MTLSConfig MTLSConfig
+	Server   string
+	Login    string
+	APIToken string
+	AuthType *AuthType
+	Insecure *bool
+	Debug    bool
+	DryRun   bool
+	// SkipNotify asks Jira not to send notifications about issue edits by passing
+	// notifyUsers=false. Transition and assignee endpoints don't support it.
+	SkipNotify bool
+	// APIVersion set to APIVersionAuto detects the deployment type of the server and
+	// routes calls like GetIssue, AssignIssue, Create and Search to v2 version of the
+	// endpoints for on-premise installations. Methods are used as is by default.
+	APIVersion string
+	// ContextPath is the path the instance is served under, eg: /jira for
+	// https://example.com/jira. It is prepended to all API endpoints.
+	ContextPath string
+	// JiraAgileEndpoint is the base URL of the Jira Agile API used for boards, sprints
+	// and ranking, eg: https://agile.example.com. It defaults to the server URL
+	// including the context path, which is where it is served on most instances.
+	JiraAgileEndpoint string
+	// DefaultMaxResults is the page size used by searches when the caller doesn't
+	// specify one, and per page in SearchAll. Defaults to 100, capped at 1000.
+	DefaultMaxResults int
+	MTLSConfig        MTLSConfig
 }
 
 // Client is a jira client.
 type Client struct {
-	transport http.RoundTripper
-	insecure  bool
-	server    string
-	login     string
-	authType  *AuthType
-	token     string
-	timeout   time.Duration
-	debug     bool
+	httpClient *http.Client
+	insecure   bool
+	server     string
+	agile      string
+	login      string
+	authType   *AuthType
+	token      string
+	timeout    time.Duration
+	connect    time.Duration
+	debug      bool
+	dryRun     bool
+	skipNotify bool
+	apiVer     string
+	pageSize   int
+	output     io.Writer
+	trace      io.Writer
+	traceBody  bool
+
+	infoMu sync.Mutex
+	info   *ServerInfo
+
+	onDeprecation DeprecationFunc
+	deprecationMu sync.Mutex
+	deprecations  map[string]struct{}
+}
+
+// JoinContextPath appends the context path to the server URL. The path is left out if
+// the server URL already ends with it so that both forms of the config are supported.
+func JoinContextPath(server, contextPath string) string {
+	server = strings.TrimSuffix(server, "/")
+
+	contextPath = strings.Trim(strings.TrimSpace(contextPath), "/")
+	if contextPath == "" {
+		return server
+	}
+	contextPath = "/" + contextPath
+
+	if strings.HasSuffix(server, contextPath) {
+		return server
+	}
+	return server + contextPath
+}
+
+// AgileEndpoint returns the base URL of the Jira Agile API. The endpoint is derived
+// from the server URL unless it is set. The version path, ie: /rest/agile/1.0, is
+// removed from the endpoint if present as it is added to each request.
+func AgileEndpoint(server, endpoint string) string {
+	endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+	if endpoint == "" {
+		return strings.TrimSuffix(server, "/")
+	}
+	return strings.TrimSuffix(endpoint, baseURLv1)
+}
+
+// checkAgileEndpoint makes sure that the Jira Agile API endpoint is an absolute URL
+// so that requests don't fail with an obscure error about the malformed URL.
+func (c *Client) checkAgileEndpoint() error {
+	if c.agile == "" {
+		return ErrNoAgileEndpoint
+	}
+	u, err := url.Parse(c.agile)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("%w: invalid URL %q", ErrNoAgileEndpoint, c.agile)
+	}
+	return nil
 }
 
 // ClientFunc decorates option for client.
@@ -131,18 +257,41 @@ type ClientFunc func(*Client)
 
 // NewClient instantiates new jira client.
 func NewClient(c Config, opts ...ClientFunc) *Client {
+	server := JoinContextPath(c.Server, c.ContextPath)
+
 	client := Client{
-		server:   strings.TrimSuffix(c.Server, "/"),
-		login:    c.Login,
-		token:    c.APIToken,
-		authType: c.AuthType,
-		debug:    c.Debug,
+		server:     server,
+		agile:      AgileEndpoint(server, c.JiraAgileEndpoint),
+		login:      c.Login,
+		token:      c.APIToken,
+		authType:   c.AuthType,
+		debug:      c.Debug,
+		dryRun:     c.DryRun,
+		skipNotify: c.SkipNotify,
+		apiVer:     c.APIVersion,
+		pageSize:   c.DefaultMaxResults,
+		output:     os.Stdout,
 	}
 
 	for _, opt := range opts {
 		opt(&client)
 	}
 
+	if client.httpClient != nil {
+		// The injected client is used as is, it is only wrapped to log requests in debug mode.
+		hc := *client.httpClient
+		if client.trace != nil {
+			base := hc.Transport
+			if base == nil {
+				base = http.DefaultTransport
+			}
+			hc.Transport = &traceTransport{base: base, w: client.trace, body: client.traceBody}
+		}
+		client.httpClient = &hc
+
+		return &client
+	}
+
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		TLSClientConfig: &tls.Config{
@@ -150,8 +299,9 @@ func NewClient(c Config, opts ...ClientFunc) *Client {
 			InsecureSkipVerify: client.insecure,
 		},
 		DialContext: (&net.Dialer{
-			Timeout: client.timeout,
+			Timeout: cmp.Or(client.connect, client.timeout),
 		}).DialContext,
+		TLSHandshakeTimeout: cmp.Or(client.connect, client.timeout),
 	}
 
 	if c.AuthType != nil && *c.AuthType == AuthTypeMTLS {
@@ -175,18 +325,36 @@ func NewClient(c Config, opts ...ClientFunc) *Client {
 		transport.TLSClientConfig.Renegotiation = tls.RenegotiateFreelyAsClient
 	}
 
-	client.transport = transport
+	var rt http.RoundTripper = &compressionTransport{base: transport}
+	if client.trace != nil {
+		rt = &traceTransport{base: rt, w: client.trace, body: client.traceBody}
+	}
+	client.httpClient = &http.Client{Transport: rt}
 
 	return &client
 }
 
-// WithTimeout is a functional opt to attach timeout to the client.
+// WithTimeout is a functional opt to attach timeout to the client. The timeout
+// covers the whole request including reading the response body. It also limits
+// the time to establish a connection unless WithConnectTimeout is used.
+//
+// The timeout is only a default: if the context passed to a request already has
+// a deadline, the context deadline is used instead, even if it is longer. This
+// allows slow calls like attachment uploads to extend the timeout per call.
 func WithTimeout(to time.Duration) ClientFunc {
 	return func(c *Client) {
 		c.timeout = to
 	}
 }
 
+// WithConnectTimeout is a functional opt to limit the time to establish a
+// connection, including the TLS handshake, separately from the overall timeout.
+func WithConnectTimeout(to time.Duration) ClientFunc {
+	return func(c *Client) {
+		c.connect = to
+	}
+}
+
 // WithInsecureTLS is a functional opt that allow you to skip TLS certificate verification.
 func WithInsecureTLS(ins bool) ClientFunc {
 	return func(c *Client) {
@@ -194,6 +362,51 @@ func WithInsecureTLS(ins bool) ClientFunc {
 	}
 }
 
+// WithHTTPClient is a functional opt to send requests with the given http client, eg: to
+// use a custom transport for TLS, tracing, retries or recorded responses in tests. The
+// client is used as is, so options that configure the default transport, ie: WithInsecureTLS,
+// WithConnectTimeout and mTLS config, have no effect. WithTimeout still applies to requests.
+func WithHTTPClient(hc *http.Client) ClientFunc {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithDebug is a functional opt that logs method, URL, status and timing of
+// each request to the writer. Authorization header is never logged.
+func WithDebug(w io.Writer) ClientFunc {
+	return func(c *Client) {
+		c.trace = w
+	}
+}
+
+// WithDebugBody is a functional opt that also logs request and response bodies
+// when used along with WithDebug.
+func WithDebugBody(body bool) ClientFunc {
+	return func(c *Client) {
+		c.traceBody = body
+	}
+}
+
+// Requester sends raw requests to v1, v2 and v3 versions of the jira api. Client
+// satisfies it, code that only needs to send requests can depend on this interface
+// instead so that it can be tested with a fake.
+type Requester interface {
+	Get(ctx context.Context, path string, headers Header) (*http.Response, error)
+	GetV2(ctx context.Context, path string, headers Header) (*http.Response, error)
+	GetV1(ctx context.Context, path string, headers Header) (*http.Response, error)
+	Post(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error)
+	PostV2(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error)
+	PostV1(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error)
+	Put(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error)
+	PutV2(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error)
+	PutV1(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error)
+	Delete(ctx context.Context, path string, headers Header) (*http.Response, error)
+	DeleteV2(ctx context.Context, path string, headers Header) (*http.Response, error)
+}
+
+var _ Requester = (*Client)(nil)
+
 // Get sends GET request to v3 version of the jira api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
 	return c.request(ctx, http.MethodGet, c.server+baseURLv3+path, nil, headers)
@@ -206,7 +419,7 @@ func (c *Client) GetV2(ctx context.Context, path string, headers Header) (*http.
 
 // GetV1 sends get request to v1 version of the jira api.
 func (c *Client) GetV1(ctx context.Context, path string, headers Header) (*http.Response, error) {
-	return c.request(ctx, http.MethodGet, c.server+baseURLv1+path, nil, headers)
+	return c.request(ctx, http.MethodGet, c.agile+baseURLv1+path, nil, headers)
 }
 
 // Post sends POST request to v3 version of the jira api.
@@ -221,7 +434,7 @@ func (c *Client) PostV2(ctx context.Context, path string, body []byte, headers H
 
 // PostV1 sends POST request to v1 version of the jira api.
 func (c *Client) PostV1(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error) {
-	return c.request(ctx, http.MethodPost, c.server+baseURLv1+path, body, headers)
+	return c.request(ctx, http.MethodPost, c.agile+baseURLv1+path, body, headers)
 }
 
 // Put sends PUT request to v3 version of the jira api.
@@ -236,7 +449,12 @@ func (c *Client) PutV2(ctx context.Context, path string, body []byte, headers He
 
 // PutV1 sends PUT request to v1 version of the jira api.
 func (c *Client) PutV1(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error) {
-	return c.request(ctx, http.MethodPut, c.server+baseURLv1+path, body, headers)
+	return c.request(ctx, http.MethodPut, c.agile+baseURLv1+path, body, headers)
+}
+
+// Delete sends DELETE request to v3 version of the jira api.
+func (c *Client) Delete(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodDelete, c.server+baseURLv3+path, nil, headers)
 }
 
 // DeleteV2 sends DELETE request to v2 version of the jira api.
@@ -251,13 +469,21 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		err error
 	)
 
+	// In dry run mode, mutating requests are printed instead of being sent.
+	// Read-only requests still go through as they are often needed to build the payload.
+	if c.dryRun && method != http.MethodGet && !isReadOnly(ctx) {
+		printDryRun(c.output, method, endpoint, body)
+		return nil, ErrDryRun
+	}
+
 	req, err = http.NewRequest(method, endpoint, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
 	defer func() {
-		if c.debug {
+		// Requests are already logged by the trace transport if it is set.
+		if c.debug && c.trace == nil {
 			dump(req, res)
 		}
 	}()
@@ -285,12 +511,70 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		req.SetBasicAuth(c.login, c.token)
 	}
 
-	httpClient := &http.Client{Transport: c.transport}
+	// Use client timeout only if the caller hasn't set a deadline for this call.
+	cancel := context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	}
+
+	res, err = c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return res, err
+	}
+
+	c.checkDeprecation(req, res)
+
+	// The context must outlive this call as the body is read by the caller.
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+type readOnlyKey struct{}
+
+// readOnly marks requests sent with the returned context as read-only so that they
+// are sent in dry run mode even if they don't use GET, eg: a search using POST.
+func readOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context) bool {
+	ro, _ := ctx.Value(readOnlyKey{}).(bool)
+	return ro
+}
+
+// cancelBody releases the request context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func printDryRun(w io.Writer, method, endpoint string, body []byte) {
+	_, _ = fmt.Fprintf(w, "%s %s\n", method, endpoint)
+	if len(body) == 0 {
+		return
+	}
 
-	return httpClient.Do(req.WithContext(ctx))
+	var out bytes.Buffer
+	if err := json.Indent(&out, body, "", "  "); err != nil {
+		out.Reset()
+		out.Write(body)
+	}
+	_, _ = fmt.Fprintf(w, "%s\n", out.String())
 }
 
 func dump(req *http.Request, res *http.Response) {
+	if req.Header.Get("Authorization") != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "REDACTED")
+	}
+
 	reqDump, _ := httputil.DumpRequest(req, true)
 	prettyPrintDump("Request Details", reqDump)
 
@@ -308,15 +592,45 @@ func prettyPrintDump(heading string, data []byte) {
 	fmt.Print(string(data))
 }
 
+// maxRawErrorLength is the maximum length of a non-JSON error body kept in the error.
+const maxRawErrorLength = 512
+
 func formatUnexpectedResponse(res *http.Response) *ErrUnexpectedResponse {
+	e := ErrUnexpectedResponse{
+		Status:     res.Status,
+		StatusCode: res.StatusCode,
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		e.Path = res.Request.URL.Path
+	}
+
+	// We don't care about read error here.
+	body, _ := io.ReadAll(res.Body)
+
 	var b Errors
+	if err := json.Unmarshal(body, &b); err != nil {
+		raw := strings.TrimSpace(string(body))
+		if len(raw) > maxRawErrorLength {
+			raw = raw[:maxRawErrorLength] + "..."
+		}
+		e.Raw = raw
+	}
 
-	// We don't care about decoding error here.
-	_ = json.NewDecoder(res.Body).Decode(&b)
+	e.Body = b
+	e.Messages = b.ErrorMessages
+	e.FieldErrors = b.Errors
 
-	return &ErrUnexpectedResponse{
-		Body:       b,
-		Status:     res.Status,
-		StatusCode: res.StatusCode,
+	return &e
+}
+
+// withNotify adds notifyUsers=false to the path if notifications are disabled.
+func (c *Client) withNotify(path string, skip bool) string {
+	if !c.skipNotify && !skip {
+		return path
+	}
+	if strings.Contains(path, "?") {
+		return path + "&notifyUsers=false"
 	}
+	return path + "?notifyUsers=false"
 }
@@ -2,10 +2,15 @@
 package jira
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +40,45 @@ func TestGet(t *testing.T) {
 	_ = resp.Body.Close()
 }
 
+func TestContextPath(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	for _, cfg := range []Config{
+		{Server: server.URL, ContextPath: "jira"},
+		{Server: server.URL + "/", ContextPath: "/jira/"},
+		{Server: server.URL + "/jira", ContextPath: "/jira"},
+		{Server: server.URL + "/jira/"},
+	} {
+		client := NewClient(cfg, WithTimeout(3*time.Second))
+
+		_, err := client.GetIssueRaw("TEST-1")
+		assert.NoError(t, err)
+
+		res, err := client.GetV1(context.Background(), "/board", nil)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+
+	for i := 0; i < len(paths); i += 2 {
+		assert.Equal(t, "/jira/rest/api/3/issue/TEST-1", paths[i])
+		assert.Equal(t, "/jira/rest/agile/1.0/board", paths[i+1])
+	}
+	assert.Len(t, paths, 8)
+}
+
+func TestJoinContextPath(t *testing.T) {
+	assert.Equal(t, "https://example.com", JoinContextPath("https://example.com/", ""))
+	assert.Equal(t, "https://example.com/jira", JoinContextPath("https://example.com", "jira"))
+	assert.Equal(t, "https://example.com/jira", JoinContextPath("https://example.com/jira/", "/jira/"))
+	assert.Equal(t, "https://example.com/tools/jira", JoinContextPath("https://example.com/tools", "jira"))
+}
+
 func TestGetV1(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/rest/agile/1.0/epic/TEST-1/issue", r.URL.Path)
@@ -55,6 +99,35 @@ func TestGetV1(t *testing.T) {
 	_ = resp.Body.Close()
 }
 
+func TestAgileEndpoint(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "https://jira.example.com/jira", AgileEndpoint("https://jira.example.com/jira/", ""))
+	assert.Equal(t, "https://agile.example.com", AgileEndpoint("https://jira.example.com", "https://agile.example.com/"))
+	assert.Equal(t, "https://agile.example.com", AgileEndpoint("https://jira.example.com", "https://agile.example.com/rest/agile/1.0"))
+
+	var paths []string
+
+	agile := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(200)
+	}))
+	defer agile.Close()
+
+	client := NewClient(Config{Server: "http://127.0.0.1:1", JiraAgileEndpoint: agile.URL + "/agile"}, WithTimeout(3*time.Second))
+
+	for _, fn := range []func() (*http.Response, error){
+		func() (*http.Response, error) { return client.GetV1(context.Background(), "/board", nil) },
+		func() (*http.Response, error) { return client.PostV1(context.Background(), "/sprint", nil, nil) },
+		func() (*http.Response, error) { return client.PutV1(context.Background(), "/issue/rank", nil, nil) },
+	} {
+		res, err := fn()
+		assert.NoError(t, err)
+		assert.NoError(t, res.Body.Close())
+	}
+	assert.Equal(t, []string{"/agile/rest/agile/1.0/board", "/agile/rest/agile/1.0/sprint", "/agile/rest/agile/1.0/issue/rank"}, paths)
+}
+
 func TestGetV2(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
@@ -207,3 +280,196 @@ func TestDeleteV2(t *testing.T) {
 
 	_ = resp.Body.Close()
 }
+
+func TestFormatUnexpectedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/issue/JSON":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"errorMessages":["Something went wrong"],"errors":{"summary":"Summary is required"}}`))
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(502)
+			_, _ = w.Write([]byte("<html>Bad Gateway</html>\n"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	res, err := client.GetV2(context.Background(), "/issue/JSON", nil)
+	assert.NoError(t, err)
+
+	e := formatUnexpectedResponse(res)
+	_ = res.Body.Close()
+
+	assert.Equal(t, 400, e.StatusCode)
+	assert.Equal(t, []string{"Something went wrong"}, e.Messages)
+	assert.Equal(t, map[string]string{"summary": "Summary is required"}, e.FieldErrors)
+	assert.Equal(t, "GET", e.Method)
+	assert.Equal(t, "/rest/api/2/issue/JSON", e.Path)
+	assert.Empty(t, e.Raw)
+	assert.Equal(t, "\nError:\n  - Something went wrong\n  - summary: Summary is required\n", e.Error())
+
+	res, err = client.GetV2(context.Background(), "/issue/HTML", nil)
+	assert.NoError(t, err)
+
+	e = formatUnexpectedResponse(res)
+	_ = res.Body.Close()
+
+	assert.Equal(t, 502, e.StatusCode)
+	assert.Empty(t, e.Messages)
+	assert.Equal(t, "<html>Bad Gateway</html>", e.Raw)
+	assert.Equal(t, "\nError:\n  - <html>Bad Gateway</html>\n", e.Error())
+}
+
+func TestErrUnexpectedResponseIs(t *testing.T) {
+	cases := []struct {
+		statusCode int
+		expected   error
+	}{
+		{statusCode: 401, expected: ErrUnauthorized},
+		{statusCode: 403, expected: ErrForbidden},
+		{statusCode: 404, expected: ErrNotFound},
+	}
+
+	for _, tc := range cases {
+		var err error = &ErrUnexpectedResponse{StatusCode: tc.statusCode}
+
+		for _, target := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound} {
+			assert.Equal(t, target == tc.expected, errors.Is(err, target), "status %d, target %s", tc.statusCode, target)
+		}
+	}
+
+	assert.False(t, errors.Is(&ErrUnexpectedResponse{StatusCode: 400}, ErrNotFound))
+	assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", &ErrUnexpectedResponse{StatusCode: 404}), ErrNotFound))
+}
+
+func TestDryRun(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(Config{Server: server.URL, DryRun: true}, WithTimeout(3*time.Second))
+	client.output = &out
+
+	res, err := client.Get(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	_, err = client.Post(context.Background(), "/issue/TEST-1/comment", []byte(`{"body":"Hello"}`), nil)
+	assert.ErrorIs(t, err, ErrDryRun)
+
+	// Read-only requests are sent regardless of the method.
+	res, err = client.Post(readOnly(context.Background()), "/search/approximate-count", []byte(`{"jql":"project=TEST"}`), nil)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	_, err = client.DeleteV2(context.Background(), "/issue/TEST-1", nil)
+	assert.ErrorIs(t, err, ErrDryRun)
+
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost}, requests)
+
+	expected := `POST ` + server.URL + `/rest/api/3/issue/TEST-1/comment
+{
+  "body": "Hello"
+}
+DELETE ` + server.URL + `/rest/api/2/issue/TEST-1
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(50*time.Millisecond), WithConnectTimeout(time.Second))
+
+	_, err := client.Get(context.Background(), "/myself", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Deadline of the context has precedence over the client timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	res, err := client.Get(ctx, "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, res.StatusCode)
+	assert.NoError(t, res.Body.Close())
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	var trace bytes.Buffer
+
+	hc := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://jira.example.com/rest/api/2/myself", req.URL.String())
+			assert.Equal(t, "Bearer t0ken", req.Header.Get("Authorization"))
+
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"accountId":"a123","displayName":"Person A"}`)),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	authType := AuthTypeBearer
+	client := NewClient(
+		Config{Server: "https://jira.example.com", APIToken: "t0ken", AuthType: &authType},
+		WithHTTPClient(hc), WithDebug(&trace),
+	)
+
+	me, err := client.Me()
+	assert.NoError(t, err)
+	assert.Equal(t, "Person A", me.Name)
+	assert.Contains(t, trace.String(), "GET https://jira.example.com/rest/api/2/myself")
+
+	// The transport of the injected client is left untouched.
+	assert.IsType(t, roundTripFunc(nil), hc.Transport)
+}
+
+func TestSkipNotify(t *testing.T) {
+	var uris []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uris = append(uris, r.URL.RequestURI())
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, SkipNotify: true}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.Edit("TEST-1", &EditRequest{Summary: "Updated"}))
+	assert.NoError(t, client.AssignIssue("TEST-1", "a123"))
+
+	_, err := client.Transition("TEST-1", &TransitionRequest{Transition: &TransitionRequestData{ID: "1"}})
+	assert.NoError(t, err)
+	assert.NoError(t, client.SetIssueParent("TEST-1", "TEST-2"))
+	assert.NoError(t, client.ClearIssueParent("TEST-1"))
+
+	assert.Equal(t, []string{
+		"/rest/api/2/issue/TEST-1?notifyUsers=false",
+		"/rest/api/3/issue/TEST-1/assignee",
+		"/rest/api/2/issue/TEST-1/transitions",
+		"/rest/api/2/issue/TEST-1?notifyUsers=false",
+		"/rest/api/2/issue/TEST-1?notifyUsers=false",
+	}, uris)
+}
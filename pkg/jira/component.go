@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetComponents fetches components of a project using GET /project/{projectIdOrKey}/components endpoint.
+func (c *Client) GetComponents(project string) ([]*Component, error) {
+	path := fmt.Sprintf("/project/%s/components", project)
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// AddIssueComponents adds components to an issue using the update verb of PUT /issue/{key} endpoint.
+// Components already set on the issue are kept as is.
+func (c *Client) AddIssueComponents(key string, components ...string) error {
+	return c.updateIssueComponents(key, "add", components)
+}
+
+// RemoveIssueComponents removes components from an issue using the update verb of PUT /issue/{key} endpoint.
+func (c *Client) RemoveIssueComponents(key string, components ...string) error {
+	return c.updateIssueComponents(key, "remove", components)
+}
+
+func (c *Client) updateIssueComponents(key, verb string, components []string) error {
+	type component struct {
+		Name string `json:"name"`
+	}
+
+	ops := make([]map[string]component, 0, len(components))
+	for _, name := range components {
+		ops = append(ops, map[string]component{verb: {Name: name}})
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"update": map[string]any{"components": ops},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV2(context.Background(), c.withNotify("/issue/"+key, false), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
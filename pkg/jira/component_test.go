@@ -0,0 +1,88 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetComponents(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/TEST/components", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/components.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetComponents("TEST")
+	assert.NoError(t, err)
+
+	backend := &Component{ID: "10000", Name: "Backend", Description: "Server side code"}
+	backend.Lead.Name = "Person A"
+
+	expected := []*Component{
+		backend,
+		{ID: "10001", Name: "Frontend"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetComponents("TEST")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddAndRemoveIssueComponents(t *testing.T) {
+	var (
+		unexpectedStatusCode bool
+		expectedBody         string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"update":{"components":[{"add":{"name":"Backend"}},{"add":{"name":"Frontend"}}]}}`
+	assert.NoError(t, client.AddIssueComponents("TEST-1", "Backend", "Frontend"))
+
+	expectedBody = `{"update":{"components":[{"remove":{"name":"Backend"}}]}}`
+	assert.NoError(t, client.RemoveIssueComponents("TEST-1", "Backend"))
+
+	unexpectedStatusCode = true
+
+	err := client.RemoveIssueComponents("TEST-1", "Backend")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
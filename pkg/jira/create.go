@@ -1,8 +1,11 @@
 package jira
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,8 +16,9 @@ import (
 
 // CreateResponse struct holds response from POST /issue endpoint.
 type CreateResponse struct {
-	ID  string `json:"id"`
-	Key string `json:"key"`
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Self string `json:"self,omitempty"`
 }
 
 // CreateRequest struct holds request data for create request.
@@ -35,6 +39,8 @@ type CreateRequest struct {
 	FixVersions      []string
 	AffectsVersions  []string
 	OriginalEstimate string
+	// SecurityLevel is the id of the security level, see GetSecurityLevels.
+	SecurityLevel string
 	// EpicField is the dynamic epic field name
 	// that changes per jira installation.
 	EpicField string
@@ -71,12 +77,44 @@ func (c *Client) Create(req *CreateRequest) (*CreateResponse, error) {
 	return c.create(req, apiVersion3)
 }
 
+// CreateIssue creates an issue using POST /issue endpoint. The version of the endpoint is
+// chosen based on the installation type set with ForInstallationType, it is detected from
+// the server if not set. Markdown body is converted to ADF for v3 and to Jira wiki markup
+// for v2 version of the endpoint.
+func (c *Client) CreateIssue(req CreateRequest) (*CreateResponse, error) {
+	if req.installationType == "" {
+		it, err := c.InstallationType()
+		if err != nil {
+			return nil, err
+		}
+		req.installationType = it
+	}
+
+	if req.installationType == InstallationTypeLocal {
+		return c.create(&req, apiVersion2)
+	}
+
+	if body, ok := req.Body.(string); ok && body != "" {
+		doc, err := md.ToADF(body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = doc
+	}
+	return c.create(&req, apiVersion3)
+}
+
 // CreateV2 creates an issue using v2 version of the POST /issue endpoint.
 func (c *Client) CreateV2(req *CreateRequest) (*CreateResponse, error) {
 	return c.create(req, apiVersion2)
 }
 
 func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error) {
+	ver = c.resolveVersion(ver)
+	if ver == apiVersion2 && req.installationType == "" {
+		req.installationType = InstallationTypeLocal
+	}
+
 	data := c.getRequestData(req)
 
 	body, err := json.Marshal(&data)
@@ -221,6 +259,11 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 			OriginalEstimate string `json:"originalEstimate,omitempty"`
 		}{OriginalEstimate: req.OriginalEstimate}
 	}
+	if req.SecurityLevel != "" {
+		data.Fields.M.Security = &struct {
+			ID string `json:"id"`
+		}{ID: req.SecurityLevel}
+	}
 
 	constructCustomFields(req.CustomFields, req.configuredCustomFields, &data)
 
@@ -313,6 +356,9 @@ type createFields struct {
 	TimeTracking *struct {
 		OriginalEstimate string `json:"originalEstimate,omitempty"`
 	} `json:"timetracking,omitempty"`
+	Security *struct {
+		ID string `json:"id"`
+	} `json:"security,omitempty"`
 	epicField    string
 	customFields customField
 }
@@ -347,3 +393,166 @@ func (cfm *createFieldsMarshaler) MarshalJSON() ([]byte, error) {
 
 	return json.Marshal(dm)
 }
+
+// bulkCreateMaxIssues is the maximum number of issues POST /issue/bulk creates in a single request.
+const bulkCreateMaxIssues = 50
+
+// BulkCreateResult holds the result of creating a single issue with CreateBulk.
+type BulkCreateResult struct {
+	ID  string
+	Key string
+	Err error
+}
+
+type bulkCreateResponse struct {
+	Issues []CreateResponse `json:"issues"`
+	Errors []struct {
+		Status        int    `json:"status"`
+		ElementErrors Errors `json:"elementErrors"`
+		FailedElement int    `json:"failedElementNumber"`
+	} `json:"errors"`
+}
+
+// CreateBulk creates issues using v3 version of the POST /issue/bulk endpoint, in batches of
+// 50 issues. Results are in the same order as the requests and failures are reported per issue.
+// Issues are created one by one if the endpoint is not available. In dry run mode, requests
+// of all batches are printed and ErrDryRun is returned.
+func (c *Client) CreateBulk(reqs []*CreateRequest) ([]BulkCreateResult, error) {
+	return c.createBulk(reqs, apiVersion3)
+}
+
+// CreateBulkV2 is the same as CreateBulk but uses v2 version of the POST /issue/bulk endpoint.
+func (c *Client) CreateBulkV2(reqs []*CreateRequest) ([]BulkCreateResult, error) {
+	return c.createBulk(reqs, apiVersion2)
+}
+
+func (c *Client) createBulk(reqs []*CreateRequest, ver string) ([]BulkCreateResult, error) {
+	out := make([]BulkCreateResult, 0, len(reqs))
+
+	var dryRun bool
+	for from := 0; from < len(reqs); from += bulkCreateMaxIssues {
+		batch := reqs[from:min(from+bulkCreateMaxIssues, len(reqs))]
+
+		res, err := c.createBatch(batch, ver)
+		if errors.Is(err, ErrNotFound) {
+			// Fallback for old servers that don't support bulk create.
+			return append(out, c.createEach(reqs[from:], ver)...), nil
+		}
+		if errors.Is(err, ErrDryRun) {
+			dryRun = true
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, res...)
+	}
+	if dryRun {
+		return nil, ErrDryRun
+	}
+
+	return out, nil
+}
+
+func (c *Client) createBatch(reqs []*CreateRequest, ver string) ([]BulkCreateResult, error) {
+	ver = c.resolveVersion(ver)
+
+	updates := make([]*createRequest, 0, len(reqs))
+	for _, req := range reqs {
+		if ver == apiVersion2 && req.installationType == "" {
+			req.installationType = InstallationTypeLocal
+		}
+		updates = append(updates, c.getRequestData(req))
+	}
+
+	body, err := json.Marshal(map[string][]*createRequest{"issueUpdates": updates})
+	if err != nil {
+		return nil, err
+	}
+
+	header := Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	}
+
+	var res *http.Response
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PostV2(context.Background(), "/issue/bulk", body, header)
+	default:
+		res, err = c.Post(context.Background(), "/issue/bulk", body, header)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	// The endpoint responds with 400 if any of the issues fails, the body still
+	// holds the created issues along with the errors of the failed ones.
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusBadRequest {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bulkCreateResponse
+	if err := json.Unmarshal(raw, &out); err != nil || (len(out.Issues) == 0 && len(out.Errors) == 0) {
+		res.Body = io.NopCloser(bytes.NewReader(raw))
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	results := make([]BulkCreateResult, len(reqs))
+	for _, e := range out.Errors {
+		if e.FailedElement < 0 || e.FailedElement >= len(reqs) {
+			continue
+		}
+		results[e.FailedElement].Err = &ErrUnexpectedResponse{
+			Body:        e.ElementErrors,
+			Status:      http.StatusText(e.Status),
+			StatusCode:  e.Status,
+			Messages:    e.ElementErrors.ErrorMessages,
+			FieldErrors: e.ElementErrors.Errors,
+			Method:      res.Request.Method,
+			Path:        res.Request.URL.Path,
+		}
+	}
+
+	// Created issues are listed in the order of the requests, skipping the failed ones.
+	created := out.Issues
+	for i := range results {
+		if results[i].Err != nil {
+			continue
+		}
+		if len(created) == 0 {
+			results[i].Err = ErrNoResult
+			continue
+		}
+		results[i].ID, results[i].Key = created[0].ID, created[0].Key
+		created = created[1:]
+	}
+
+	return results, nil
+}
+
+func (c *Client) createEach(reqs []*CreateRequest, ver string) []BulkCreateResult {
+	out := make([]BulkCreateResult, 0, len(reqs))
+
+	for _, req := range reqs {
+		res, err := c.create(req, ver)
+		if err != nil {
+			out = append(out, BulkCreateResult{Err: err})
+			continue
+		}
+		out = append(out, BulkCreateResult{ID: res.ID, Key: res.Key})
+	}
+
+	return out
+}
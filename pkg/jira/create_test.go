@@ -1,6 +1,8 @@
 package jira
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -82,6 +84,25 @@ func TestCreate(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestCreateWithSecurityLevel(t *testing.T) {
+	expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},` +
+		`"summary":"Test bug","security":{"id":"10000"}}}`
+	testServer := createTestServer{code: 201}
+	server := testServer.serve(t, expectedBody)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	requestData := CreateRequest{
+		Project:       "TEST",
+		IssueType:     "Bug",
+		Summary:       "Test bug",
+		SecurityLevel: "10000",
+	}
+	_, err := client.CreateV2(&requestData)
+	assert.NoError(t, err)
+}
+
 func TestCreateSubtask(t *testing.T) {
 	expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Sub-task"},` +
 		`"parent":{"key":"TEST-123"},"summary":"Test sub-task","description":"Test description"}}`
@@ -178,3 +199,161 @@ func TestCreateEpicNextGen(t *testing.T) {
 	_, err = client.CreateV2(&requestData)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateIssue(t *testing.T) {
+	var paths []string
+
+	deploymentType := DeploymentTypeCloud
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/rest/api/2/serverInfo" {
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"deploymentType":"` + deploymentType + `"}`))
+			return
+		}
+
+		body := new(strings.Builder)
+		_, _ = io.Copy(body, r.Body)
+
+		if r.URL.Path == "/rest/api/3/issue" {
+			assert.Contains(t, body.String(), `"type":"heading"`)
+			assert.Contains(t, body.String(), `"assignee":{"accountId":"a12b3"}`)
+		} else {
+			assert.Contains(t, body.String(), `"description":"h1. Title\n"`)
+			assert.Contains(t, body.String(), `"assignee":{"name":"a12b3"}`)
+		}
+
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id":"10057","key":"TEST-3","self":"https://test.atlassian.net/rest/api/3/issue/10057"}`))
+	}))
+	defer server.Close()
+
+	req := CreateRequest{
+		Project:   "TEST",
+		IssueType: "Bug",
+		Summary:   "Test bug",
+		Body:      "# Title",
+		Assignee:  "a12b3",
+	}
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.CreateIssue(req)
+	assert.NoError(t, err)
+	assert.Equal(t, &CreateResponse{
+		ID:   "10057",
+		Key:  "TEST-3",
+		Self: "https://test.atlassian.net/rest/api/3/issue/10057",
+	}, actual)
+	assert.Equal(t, []string{"/rest/api/2/serverInfo", "/rest/api/3/issue"}, paths)
+	assert.Equal(t, "# Title", req.Body)
+
+	paths = nil
+	deploymentType = DeploymentTypeDataCenter
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err = client.CreateIssue(req)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/rest/api/2/serverInfo", "/rest/api/2/issue"}, paths)
+}
+
+func TestCreateBulkV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/bulk", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(t, `{"issueUpdates":[
+			{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"First"}},
+			{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"Second"}},
+			{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Task"},"summary":"Third"}}
+		]}`, actualBody.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{
+			"issues": [{"id":"10001","key":"TEST-1"},{"id":"10003","key":"TEST-3"}],
+			"errors": [{"status":400,"elementErrors":{"errors":{"priority":"Priority is required"}},"failedElementNumber":1}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	results, err := client.CreateBulkV2([]*CreateRequest{
+		{Project: "TEST", IssueType: "Bug", Summary: "First"},
+		{Project: "TEST", IssueType: "Bug", Summary: "Second"},
+		{Project: "TEST", IssueType: "Task", Summary: "Third"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	assert.Equal(t, BulkCreateResult{ID: "10001", Key: "TEST-1"}, results[0])
+	assert.Equal(t, BulkCreateResult{ID: "10003", Key: "TEST-3"}, results[2])
+
+	assert.Empty(t, results[1].Key)
+	assert.ErrorIs(t, results[1].Err, ErrBadRequest)
+	assert.Contains(t, results[1].Err.Error(), "priority: Priority is required")
+}
+
+func TestCreateBulkDryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(Config{Server: server.URL, DryRun: true}, WithTimeout(3*time.Second))
+	client.output = &out
+
+	reqs := make([]*CreateRequest, 0, 120)
+	for i := 1; i <= 120; i++ {
+		reqs = append(reqs, &CreateRequest{Project: "TEST", IssueType: "Task", Summary: fmt.Sprintf("Issue %d", i)})
+	}
+
+	_, err := client.CreateBulkV2(reqs)
+	assert.ErrorIs(t, err, ErrDryRun)
+	assert.Equal(t, 3, strings.Count(out.String(), "POST "+server.URL+"/rest/api/2/issue/bulk"))
+	assert.Contains(t, out.String(), "Issue 120")
+}
+
+func TestCreateBulkFallback(t *testing.T) {
+	var created int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/issue/bulk" {
+			w.WriteHeader(404)
+			return
+		}
+		assert.Equal(t, "/rest/api/2/issue", r.URL.Path)
+
+		created++
+		w.Header().Set("Content-Type", "application/json")
+		if created == 2 {
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"errorMessages":["Invalid issue type"]}`))
+			return
+		}
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"id":"1000%d","key":"TEST-%d"}`, created, created)))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	results, err := client.CreateBulkV2([]*CreateRequest{
+		{Project: "TEST", IssueType: "Bug", Summary: "First"},
+		{Project: "TEST", IssueType: "Invalid", Summary: "Second"},
+		{Project: "TEST", IssueType: "Task", Summary: "Third"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-1", results[0].Key)
+	assert.ErrorIs(t, results[1].Err, ErrBadRequest)
+	assert.Equal(t, "TEST-3", results[2].Key)
+}
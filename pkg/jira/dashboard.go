@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Dashboard holds info of a Jira dashboard.
+type Dashboard struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Owner       *User  `json:"owner,omitempty"`
+	Favourite   bool   `json:"isFavourite"`
+	View        string `json:"view,omitempty"`
+}
+
+// DashboardList holds response from /dashboard endpoint.
+type DashboardList struct {
+	StartAt    int          `json:"startAt"`
+	MaxResults int          `json:"maxResults"`
+	Total      int          `json:"total"`
+	Prev       string       `json:"prev,omitempty"`
+	Next       string       `json:"next,omitempty"`
+	Dashboards []*Dashboard `json:"dashboards"`
+}
+
+// GetDashboards fetches a page of dashboards the user has access to using v2 version
+// of the GET /dashboard endpoint. The endpoint is available in both cloud and on-premise
+// installations.
+func (c *Client) GetDashboards(startAt, maxResults int) (*DashboardList, error) {
+	params := url.Values{}
+	params.Set("startAt", strconv.Itoa(startAt))
+	params.Set("maxResults", strconv.Itoa(maxResults))
+
+	res, err := c.GetV2(context.Background(), "/dashboard?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out DashboardList
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
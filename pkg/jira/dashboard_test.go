@@ -0,0 +1,70 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDashboards(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/dashboard", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"startAt":    []string{"20"},
+			"maxResults": []string{"10"},
+		}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"startAt": 20,
+			"maxResults": 10,
+			"total": 22,
+			"prev": "https://test.local/rest/api/2/dashboard?startAt=10",
+			"dashboards": [
+				{"id": "10000", "name": "Team board", "isFavourite": true, "owner": {"displayName": "Person A"}, "view": "https://test.local/secure/Dashboard.jspa?selectPageId=10000"},
+				{"id": "10001", "name": "Release health"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetDashboards(20, 10)
+	assert.NoError(t, err)
+
+	expected := &DashboardList{
+		StartAt:    20,
+		MaxResults: 10,
+		Total:      22,
+		Prev:       "https://test.local/rest/api/2/dashboard?startAt=10",
+		Dashboards: []*Dashboard{
+			{
+				ID:        "10000",
+				Name:      "Team board",
+				Favourite: true,
+				Owner:     &User{DisplayName: "Person A"},
+				View:      "https://test.local/secure/Dashboard.jspa?selectPageId=10000",
+			},
+			{ID: "10001", Name: "Release health"},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetDashboards(20, 10)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
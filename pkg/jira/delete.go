@@ -2,11 +2,18 @@ package jira
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+// ErrIssueHasSubtasks is returned when deleting an issue that has subtasks without cascade.
+var ErrIssueHasSubtasks = errors.New("jira: issue has subtasks")
+
 // DeleteIssue deletes an issue using /issue/{key} endpoint.
+//
+// Jira refuses to delete an issue with subtasks unless cascade is set,
+// ErrIssueHasSubtasks is returned in that case.
 func (c *Client) DeleteIssue(key string, cascade bool) error {
 	path := fmt.Sprintf("/issue/%s", key)
 	if cascade {
@@ -23,7 +30,17 @@ func (c *Client) DeleteIssue(key string, cascade bool) error {
 	defer func() { _ = res.Body.Close() }()
 
 	if res.StatusCode != http.StatusNoContent {
-		return formatUnexpectedResponse(res)
+		e := formatUnexpectedResponse(res)
+		if !cascade && res.StatusCode == http.StatusBadRequest && c.hasSubtasks(key) {
+			return ErrIssueHasSubtasks
+		}
+		return e
 	}
 	return nil
 }
+
+// hasSubtasks reports whether the issue has any subtasks.
+func (c *Client) hasSubtasks(key string) bool {
+	issue, err := c.GetIssueV2(key)
+	return err == nil && len(issue.Fields.Subtasks) > 0
+}
@@ -14,7 +14,7 @@ func TestDeleteIssue(t *testing.T) {
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if unexpectedStatusCode {
-			assert.Equal(t, "/rest/api/2/issue/BAD", r.URL.RequestURI())
+			assert.Equal(t, "/rest/api/2/issue/BAD", r.URL.Path)
 			w.WriteHeader(400)
 		} else {
 			assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.RequestURI())
@@ -46,3 +46,42 @@ func TestDeleteIssueWithCascade(t *testing.T) {
 	err := client.DeleteIssue("TEST-1", true)
 	assert.NoError(t, err)
 }
+
+func TestDeleteIssueWithSubtasks(t *testing.T) {
+	var subtasks bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			w.WriteHeader(200)
+			if subtasks {
+				_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{"subtasks":[{"key":"TEST-2"}]}}`))
+			} else {
+				_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{"subtasks":[]}}`))
+			}
+			return
+		}
+
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{"errorMessages":["The issue has sub-tasks."],"errors":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	subtasks = true
+
+	err := client.DeleteIssue("TEST-1", false)
+	assert.ErrorIs(t, err, ErrIssueHasSubtasks)
+
+	// Other failures are returned as is.
+	subtasks = false
+
+	err = client.DeleteIssue("TEST-1", false)
+	assert.NotErrorIs(t, err, ErrIssueHasSubtasks)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
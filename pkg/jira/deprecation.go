@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Deprecation holds the deprecation notice sent by the server along with a response
+// of a deprecated endpoint, eg: the old GET /search endpoint.
+type Deprecation struct {
+	Method string
+	Path   string
+	// Deprecation is the value of the Deprecation header, usually a date or "true".
+	Deprecation string
+	// Sunset is the value of the Sunset header, the date after which the endpoint is removed.
+	Sunset string
+	// Warning is the value of the Warning header, eg: 299 - "This endpoint is deprecated".
+	Warning string
+}
+
+// DeprecationFunc is called with the deprecation notice sent by the server.
+type DeprecationFunc func(Deprecation)
+
+// WithDeprecationHandler is a functional opt to get notified when the server marks an
+// endpoint as deprecated. The handler is called only once per distinct notice, so
+// repeated calls to the same endpoint don't result in duplicate warnings.
+func WithDeprecationHandler(fn DeprecationFunc) ClientFunc {
+	return func(c *Client) {
+		c.onDeprecation = fn
+	}
+}
+
+func (c *Client) checkDeprecation(req *http.Request, res *http.Response) {
+	if c.onDeprecation == nil || res == nil {
+		return
+	}
+
+	d := Deprecation{
+		Method:      req.Method,
+		Path:        req.URL.Path,
+		Deprecation: strings.TrimSpace(res.Header.Get("Deprecation")),
+		Sunset:      strings.TrimSpace(res.Header.Get("Sunset")),
+		Warning:     strings.TrimSpace(strings.Join(res.Header.Values("Warning"), ", ")),
+	}
+	if d.Deprecation == "" && d.Sunset == "" && d.Warning == "" {
+		return
+	}
+
+	key := d.Deprecation + "\x00" + d.Sunset + "\x00" + d.Warning
+
+	c.deprecationMu.Lock()
+	if c.deprecations == nil {
+		c.deprecations = make(map[string]struct{})
+	}
+	_, seen := c.deprecations[key]
+	c.deprecations[key] = struct{}{}
+	c.deprecationMu.Unlock()
+
+	if !seen {
+		c.onDeprecation(d)
+	}
+}
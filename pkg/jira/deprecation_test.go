@@ -0,0 +1,51 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/search" {
+			w.Header().Set("Sunset", "Fri, 01 May 2026 00:00:00 GMT")
+			w.Header().Set("Warning", `299 - "The requested API has been removed"`)
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	var notices []Deprecation
+
+	client := NewClient(
+		Config{Server: server.URL},
+		WithTimeout(3*time.Second),
+		WithDeprecationHandler(func(d Deprecation) {
+			notices = append(notices, d)
+		}),
+	)
+
+	for i := 0; i < 3; i++ {
+		res, err := client.GetV2(context.Background(), "/search?jql=project=TEST", nil)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+
+	res, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	assert.Equal(t, []Deprecation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/rest/api/2/search",
+			Sunset:  "Fri, 01 May 2026 00:00:00 GMT",
+			Warning: `299 - "The requested API has been removed"`,
+		},
+	}, notices)
+}
@@ -3,4 +3,12 @@
 //
 // See v3: https://developer.atlassian.com/cloud/jira/platform/rest/v3/intro/,
 // and v1: https://developer.atlassian.com/cloud/jira/software/rest/intro/
+//
+// Timeouts: WithConnectTimeout limits establishing a connection and WithTimeout
+// limits the whole request. Methods that accept a context use the context deadline
+// instead of the client timeout if one is set, eg:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	issue, err := client.GetIssueCtx(ctx, "TEST-1")
 package jira
@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Jira time tracking defaults used to format durations.
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 8 * secondsPerHour
+	secondsPerWeek   = 5 * secondsPerDay
+)
+
+// durationUnits are the time units accepted by Jira in the order they must appear.
+var durationUnits = []string{"w", "d", "h", "m"}
+
+var (
+	durationPartRegex   = regexp.MustCompile(`^(\d+(?:\.\d+)?)([a-zA-Z]+)$`)
+	durationJoinedRegex = regexp.MustCompile(`([a-zA-Z])\s*(\d)`)
+)
+
+// ParseJiraDuration validates a duration like `1w 2d 3h 30m` and returns it normalized to the
+// format accepted by Jira. Units can be weeks (w), days (d), hours (h) and minutes (m), each
+// used at most once. Parts can be separated by spaces or written together, eg: `1h30m`.
+func ParseJiraDuration(s string) (string, error) {
+	input := strings.TrimSpace(s)
+	if input == "" {
+		return "", fmt.Errorf("duration is empty")
+	}
+
+	// Split parts written together, eg: 1h30m, at each unit boundary.
+	input = durationJoinedRegex.ReplaceAllString(input, "$1 $2")
+
+	parts := make(map[string]string, len(durationUnits))
+	for _, p := range strings.Fields(input) {
+		m := durationPartRegex.FindStringSubmatch(p)
+		if m == nil {
+			return "", fmt.Errorf("invalid duration %q: %q should be a number followed by a unit, eg: 2d 1h 30m", s, p)
+		}
+
+		unit := strings.ToLower(m[2])
+		if !isDurationUnit(unit) {
+			return "", fmt.Errorf("invalid duration %q: unknown unit %q, use w, d, h or m", s, m[2])
+		}
+		if _, ok := parts[unit]; ok {
+			return "", fmt.Errorf("invalid duration %q: unit %q is used more than once", s, unit)
+		}
+		parts[unit] = m[1]
+	}
+
+	out := make([]string, 0, len(parts))
+	for _, u := range durationUnits {
+		if v, ok := parts[u]; ok {
+			out = append(out, v+u)
+		}
+	}
+	return strings.Join(out, " "), nil
+}
+
+func isDurationUnit(u string) bool {
+	for _, du := range durationUnits {
+		if du == u {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatJiraDuration formats the duration in seconds the same way Jira does, eg: 1w 3d 2h.
+// It uses Jira defaults of 8 hours a day and 5 days a week and ignores seconds.
+func FormatJiraDuration(seconds int) string {
+	if seconds < secondsPerMinute {
+		return "0m"
+	}
+
+	var out []string
+
+	for _, u := range []struct {
+		unit    string
+		seconds int
+	}{
+		{"w", secondsPerWeek},
+		{"d", secondsPerDay},
+		{"h", secondsPerHour},
+		{"m", secondsPerMinute},
+	} {
+		if n := seconds / u.seconds; n > 0 {
+			out = append(out, fmt.Sprintf("%d%s", n, u.unit))
+			seconds -= n * u.seconds
+		}
+	}
+	return strings.Join(out, " ")
+}
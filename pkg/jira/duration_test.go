@@ -0,0 +1,58 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJiraDuration(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		err      string
+	}{
+		{input: "1w 2d 3h 30m", expected: "1w 2d 3h 30m"},
+		{input: "  30m   1H ", expected: "1h 30m"},
+		{input: "1h30m", expected: "1h 30m"},
+		{input: "1.5h", expected: "1.5h"},
+		{input: "0h", expected: "0h"},
+		{input: "", err: `duration is empty`},
+		{input: "1hr", err: `invalid duration "1hr": unknown unit "hr", use w, d, h or m`},
+		{input: "2", err: `invalid duration "2": "2" should be a number followed by a unit, eg: 2d 1h 30m`},
+		{input: "h", err: `invalid duration "h": "h" should be a number followed by a unit, eg: 2d 1h 30m`},
+		{input: "1h 2h", err: `invalid duration "1h 2h": unit "h" is used more than once`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.input, func(t *testing.T) {
+			actual, err := ParseJiraDuration(tc.input)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestFormatJiraDuration(t *testing.T) {
+	cases := []struct {
+		seconds  int
+		expected string
+	}{
+		{seconds: 0, expected: "0m"},
+		{seconds: 59, expected: "0m"},
+		{seconds: 90 * 60, expected: "1h 30m"},
+		{seconds: 8 * 60 * 60, expected: "1d"},
+		{seconds: (3*8 + 2) * 60 * 60, expected: "3d 2h"},
+		{seconds: (6*8+1)*60*60 + 5*60, expected: "1w 1d 1h 5m"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, FormatJiraDuration(tc.seconds))
+	}
+}
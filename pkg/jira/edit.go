@@ -30,12 +30,17 @@ type EditRequest struct {
 	Components      []string
 	FixVersions     []string
 	AffectsVersions []string
+	// SecurityLevel is the id of the security level, see GetSecurityLevels.
+	SecurityLevel string
+	// OriginalEstimate sets the original estimate without logging any work, eg: 3d 4h.
+	OriginalEstimate string
 	// CustomFields holds all custom fields passed
 	// while editing the issue.
 	CustomFields map[string]string
 	SkipNotify   bool
 
 	configuredCustomFields []IssueTypeField
+	versionIDs             map[string]string
 }
 
 // WithCustomFields sets valid custom fields for the issue.
@@ -43,6 +48,25 @@ func (er *EditRequest) WithCustomFields(cf []IssueTypeField) {
 	er.configuredCustomFields = cf
 }
 
+// WithVersionIDs sets ids of the versions by name so that fix versions are
+// referenced by id, see GetVersionIDs. Versions without an id are sent by name.
+func (er *EditRequest) WithVersionIDs(ids map[string]string) {
+	er.versionIDs = ids
+}
+
+// versionRef references a version by id if it is known, by name otherwise.
+type versionRef struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func (er *EditRequest) versionRef(name string) *versionRef {
+	if id, ok := er.versionIDs[name]; ok {
+		return &versionRef{ID: id}
+	}
+	return &versionRef{Name: name}
+}
+
 // Edit updates an issue using POST /issue endpoint.
 func (c *Client) Edit(key string, req *EditRequest) error {
 	data := getRequestDataForEdit(req)
@@ -52,12 +76,7 @@ func (c *Client) Edit(key string, req *EditRequest) error {
 		return err
 	}
 
-	endpoint := "/issue/" + key
-	if req.SkipNotify {
-		endpoint += "?notifyUsers=false"
-	}
-
-	res, err := c.PutV2(context.Background(), endpoint, body, Header{
+	res, err := c.PutV2(context.Background(), c.withNotify("/issue/"+key, req.SkipNotify), body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -101,12 +120,8 @@ type editFields struct {
 		} `json:"remove,omitempty"`
 	} `json:"components,omitempty"`
 	FixVersions []struct {
-		Add *struct {
-			Name string `json:"name,omitempty"`
-		} `json:"add,omitempty"`
-		Remove *struct {
-			Name string `json:"name,omitempty"`
-		} `json:"remove,omitempty"`
+		Add    *versionRef `json:"add,omitempty"`
+		Remove *versionRef `json:"remove,omitempty"`
 	} `json:"fixVersions,omitempty"`
 	AffectsVersions []struct {
 		Add *struct {
@@ -116,6 +131,11 @@ type editFields struct {
 			Name string `json:"name,omitempty"`
 		} `json:"remove,omitempty"`
 	} `json:"versions,omitempty"`
+	Security []struct {
+		Set struct {
+			ID string `json:"id"`
+		} `json:"set"`
+	} `json:"security,omitempty"`
 
 	customFields customField
 }
@@ -159,12 +179,17 @@ func (cfm *editFieldsMarshaler) MarshalJSON() ([]byte, error) {
 
 type editRequest struct {
 	Update editFieldsMarshaler `json:"update"`
-	Fields struct {
-		Parent *struct {
-			Key string `json:"key,omitempty"`
-			Set string `json:"set,omitempty"`
-		} `json:"parent,omitempty"`
-	} `json:"fields"`
+	Fields editRequestFields   `json:"fields"`
+}
+
+type editRequestFields struct {
+	Parent *struct {
+		Key string `json:"key,omitempty"`
+		Set string `json:"set,omitempty"`
+	} `json:"parent,omitempty"`
+	TimeTracking *struct {
+		OriginalEstimate string `json:"originalEstimate,omitempty"`
+	} `json:"timetracking,omitempty"`
 }
 
 func getRequestDataForEdit(req *EditRequest) *editRequest {
@@ -254,37 +279,21 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 		add, sub := splitAddAndRemove(req.FixVersions)
 
 		versions := make([]struct {
-			Add *struct {
-				Name string `json:"name,omitempty"`
-			} `json:"add,omitempty"`
-			Remove *struct {
-				Name string `json:"name,omitempty"`
-			} `json:"remove,omitempty"`
+			Add    *versionRef `json:"add,omitempty"`
+			Remove *versionRef `json:"remove,omitempty"`
 		}, 0, len(req.FixVersions))
 
 		for _, v := range sub {
 			versions = append(versions, struct {
-				Add *struct {
-					Name string `json:"name,omitempty"`
-				} `json:"add,omitempty"`
-				Remove *struct {
-					Name string `json:"name,omitempty"`
-				} `json:"remove,omitempty"`
-			}{Remove: &struct {
-				Name string `json:"name,omitempty"`
-			}{Name: v}})
+				Add    *versionRef `json:"add,omitempty"`
+				Remove *versionRef `json:"remove,omitempty"`
+			}{Remove: req.versionRef(v)})
 		}
 		for _, v := range add {
 			versions = append(versions, struct {
-				Add *struct {
-					Name string `json:"name,omitempty"`
-				} `json:"add,omitempty"`
-				Remove *struct {
-					Name string `json:"name,omitempty"`
-				} `json:"remove,omitempty"`
-			}{Add: &struct {
-				Name string `json:"name,omitempty"`
-			}{Name: v}})
+				Add    *versionRef `json:"add,omitempty"`
+				Remove *versionRef `json:"remove,omitempty"`
+			}{Add: req.versionRef(v)})
 		}
 
 		update.M.FixVersions = versions
@@ -329,13 +338,18 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 
 		update.M.AffectsVersions = versions
 	}
+	if req.SecurityLevel != "" {
+		security := struct {
+			Set struct {
+				ID string `json:"id"`
+			} `json:"set"`
+		}{}
+		security.Set.ID = req.SecurityLevel
 
-	fields := struct {
-		Parent *struct {
-			Key string `json:"key,omitempty"`
-			Set string `json:"set,omitempty"`
-		} `json:"parent,omitempty"`
-	}{
+		update.M.Security = append(update.M.Security, security)
+	}
+
+	fields := editRequestFields{
 		Parent: &struct {
 			Key string `json:"key,omitempty"`
 			Set string `json:"set,omitempty"`
@@ -348,6 +362,11 @@ func getRequestDataForEdit(req *EditRequest) *editRequest {
 			fields.Parent.Key = req.ParentIssueKey
 		}
 	}
+	if req.OriginalEstimate != "" {
+		fields.TimeTracking = &struct {
+			OriginalEstimate string `json:"originalEstimate,omitempty"`
+		}{OriginalEstimate: req.OriginalEstimate}
+	}
 
 	data := editRequest{
 		Update: update,
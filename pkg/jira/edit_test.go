@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditOriginalEstimate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"update":{},"fields":{"parent":{},"timetracking":{"originalEstimate":"3d"}}}`, string(body))
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.Edit("TEST-1", &EditRequest{OriginalEstimate: "3d"}))
+
+	// Time tracking is left untouched if the estimate is not set.
+	body, err := json.Marshal(getRequestDataForEdit(&EditRequest{Summary: "Updated"}))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(body), "timetracking")
+}
+
+func TestEditFixVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "notifyUsers=false", r.URL.RawQuery)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"update":{"summary":[{"set":"Updated"}],"fixVersions":[{"remove":{"id":"1000"}},{"add":{"id":"1001"}},{"add":{"name":"v3"}}]},"fields":{"parent":{}}}`, string(body))
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := EditRequest{Summary: "Updated", FixVersions: []string{"-First", "Second", "v3"}, SkipNotify: true}
+	req.WithVersionIDs(map[string]string{"First": "1000", "Second": "1001"})
+
+	assert.NoError(t, client.Edit("TEST-1", &req))
+}
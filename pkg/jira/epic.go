@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 const (
@@ -103,3 +104,95 @@ func (c *Client) EpicIssuesRemove(issues ...string) error {
 	}
 	return nil
 }
+
+// AddIssuesToEpic adds issues to the epic. Issues of company-managed (classic) projects are
+// added using the agile epic endpoint. Team-managed (next-gen) projects link issues to the
+// epic using the parent field instead, so the parent of each issue is set to the epic.
+// The style is looked up from the project of the epic. It continues on individual failures
+// and returns result for each issue in the given order.
+func (c *Client) AddIssuesToEpic(ctx context.Context, key string, issues []string) ([]BulkResult, error) {
+	project, _, _ := strings.Cut(key, "-")
+
+	nextGen, err := c.isNextGenProject(project)
+	if err != nil {
+		return nil, err
+	}
+	if !nextGen {
+		if err := c.EpicIssuesAdd(key, issues...); err != nil {
+			return nil, err
+		}
+		return bulkSucceeded(issues), nil
+	}
+	return c.runBulk(ctx, issues, func(iss string) error {
+		return c.Edit(iss, &EditRequest{ParentIssueKey: key, SkipNotify: true})
+	}), nil
+}
+
+// RemoveIssuesFromEpic removes issues from their epic. Issues of company-managed (classic)
+// projects are removed using the agile epic endpoint whereas the parent field of issues of
+// team-managed (next-gen) projects is cleared. The style is looked up from the project of
+// each issue. It continues on individual failures and returns result for each issue in the
+// given order.
+func (c *Client) RemoveIssuesFromEpic(ctx context.Context, issues []string) ([]BulkResult, error) {
+	var (
+		classic, nextGen []string
+		styles           = make(map[string]bool)
+	)
+
+	for _, iss := range issues {
+		project, _, _ := strings.Cut(iss, "-")
+
+		ng, ok := styles[project]
+		if !ok {
+			var err error
+			if ng, err = c.isNextGenProject(project); err != nil {
+				return nil, err
+			}
+			styles[project] = ng
+		}
+		if ng {
+			nextGen = append(nextGen, iss)
+		} else {
+			classic = append(classic, iss)
+		}
+	}
+
+	results := make(map[string]BulkResult, len(issues))
+	if len(classic) > 0 {
+		if err := c.EpicIssuesRemove(classic...); err != nil {
+			return nil, err
+		}
+		for _, r := range bulkSucceeded(classic) {
+			results[r.Key] = r
+		}
+	}
+	for _, r := range c.runBulk(ctx, nextGen, func(iss string) error {
+		return c.Edit(iss, &EditRequest{ParentIssueKey: AssigneeNone, SkipNotify: true})
+	}) {
+		results[r.Key] = r
+	}
+
+	out := make([]BulkResult, len(issues))
+	for i, iss := range issues {
+		out[i] = results[iss]
+	}
+	return out, nil
+}
+
+// bulkSucceeded returns a successful result for each issue.
+func bulkSucceeded(issues []string) []BulkResult {
+	out := make([]BulkResult, len(issues))
+	for i, iss := range issues {
+		out[i] = BulkResult{Key: iss}
+	}
+	return out
+}
+
+// isNextGenProject reports whether the project is a team-managed (next-gen) project.
+func (c *Client) isNextGenProject(key string) (bool, error) {
+	project, err := c.GetProject(key)
+	if err != nil {
+		return false, err
+	}
+	return project.Type == ProjectTypeNextGen, nil
+}
@@ -2,6 +2,7 @@
 package jira
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -67,9 +68,7 @@ func TestEpicIssues(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 1},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
+					Status:  IssueStatus{Name: "To Do"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -90,9 +89,7 @@ func TestEpicIssues(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 12},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "In Progress"},
+					Status:  IssueStatus{Name: "In Progress"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -119,9 +116,7 @@ func TestEpicIssues(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: false, WatchCount: 3},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "Done"},
+					Status:  IssueStatus{Name: "Done"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -205,3 +200,75 @@ func TestEpicIssuesRemove(t *testing.T) {
 	err = client.EpicIssuesRemove("TEST-1", "TEST-2")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func epicStyleServer(t *testing.T, edited *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := new(strings.Builder)
+		_, _ = io.Copy(body, r.Body)
+
+		switch {
+		case r.URL.Path == "/rest/api/2/project/TEST":
+			_, _ = w.Write([]byte(`{"key":"TEST","style":"classic"}`))
+		case r.URL.Path == "/rest/api/2/project/NEXT":
+			_, _ = w.Write([]byte(`{"key":"NEXT","style":"next-gen"}`))
+		case r.URL.Path == "/rest/agile/1.0/epic/TEST-0/issue":
+			assert.JSONEq(t, `{"issues":["TEST-1","TEST-2"]}`, body.String())
+			w.WriteHeader(204)
+		case r.URL.Path == "/rest/agile/1.0/epic/none/issue":
+			assert.JSONEq(t, `{"issues":["TEST-1"]}`, body.String())
+			w.WriteHeader(204)
+		case strings.HasPrefix(r.URL.Path, "/rest/api/2/issue/NEXT-"):
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "notifyUsers=false", r.URL.RawQuery)
+
+			*edited = append(*edited, strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")+" "+body.String())
+			if r.URL.Path == "/rest/api/2/issue/NEXT-9" {
+				w.WriteHeader(400)
+				return
+			}
+			w.WriteHeader(204)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestAddIssuesToEpic(t *testing.T) {
+	var edited []string
+
+	server := epicStyleServer(t, &edited)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	// Classic projects use the agile epic endpoint.
+	results, err := client.AddIssuesToEpic(context.Background(), "TEST-0", []string{"TEST-1", "TEST-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []BulkResult{{Key: "TEST-1"}, {Key: "TEST-2"}}, results)
+	assert.Empty(t, edited)
+
+	// Next-gen projects set the parent of each issue.
+	results, err = client.AddIssuesToEpic(context.Background(), "NEXT-0", []string{"NEXT-1", "NEXT-9"})
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.ElementsMatch(t, []string{
+		`NEXT-1 {"update":{},"fields":{"parent":{"key":"NEXT-0"}}}`,
+		`NEXT-9 {"update":{},"fields":{"parent":{"key":"NEXT-0"}}}`,
+	}, edited)
+}
+
+func TestRemoveIssuesFromEpic(t *testing.T) {
+	var edited []string
+
+	server := epicStyleServer(t, &edited)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	results, err := client.RemoveIssuesFromEpic(context.Background(), []string{"NEXT-1", "TEST-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []BulkResult{{Key: "NEXT-1"}, {Key: "TEST-1"}}, results)
+	assert.Equal(t, []string{`NEXT-1 {"update":{},"fields":{"parent":{"set":"none"}}}`}, edited)
+}
@@ -0,0 +1,189 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrFilterNotFound is returned when a saved filter doesn't exist or the user can't access it.
+var ErrFilterNotFound = errors.New("jira: filter not found or not accessible")
+
+// Filter holds info of a saved filter.
+type Filter struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	JQL         string `json:"jql"`
+	Favourite   bool   `json:"favourite"`
+	Owner       *User  `json:"owner,omitempty"`
+	ViewURL     string `json:"viewUrl,omitempty"`
+}
+
+// FilterResult holds response from /filter/search endpoint.
+type FilterResult struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Filters    []*Filter `json:"values"`
+}
+
+// GetFilters fetches all filters the user has access to using GET /filter/search endpoint,
+// following pagination until every filter is read. The endpoint is only available in Jira cloud.
+func (c *Client) GetFilters() ([]*Filter, error) {
+	var filters []*Filter
+
+	for {
+		path := fmt.Sprintf("/filter/search?expand=jql,favourite,owner,viewUrl,description&startAt=%d", len(filters))
+
+		res, err := c.Get(context.Background(), path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		var out FilterResult
+
+		err = func() error {
+			defer func() { _ = res.Body.Close() }()
+
+			if res.StatusCode != http.StatusOK {
+				return formatUnexpectedResponse(res)
+			}
+			return json.NewDecoder(res.Body).Decode(&out)
+		}()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, out.Filters...)
+
+		if out.IsLast || len(out.Filters) == 0 || len(filters) >= out.Total {
+			break
+		}
+	}
+
+	return filters, nil
+}
+
+// GetFavouriteFilters fetches filters favourited by the user using GET /filter/favourite endpoint.
+// Use it for on-premise installations where /filter/search is not available.
+func (c *Client) GetFavouriteFilters() ([]*Filter, error) {
+	res, err := c.GetV2(context.Background(), "/filter/favourite", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Filter
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// GetFilter fetches a saved filter using GET /filter/{id} endpoint.
+//
+// Jira responds with 400 or 404 if the filter doesn't exist or is not shared
+// with the user, ErrFilterNotFound is returned in that case.
+func (c *Client) GetFilter(id string) (*Filter, error) {
+	res, err := c.GetV2(context.Background(), "/filter/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusBadRequest, http.StatusNotFound:
+		return nil, ErrFilterNotFound
+	default:
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Filter
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetFilterJQL fetches the JQL query of a saved filter.
+func (c *Client) GetFilterJQL(id string) (string, error) {
+	f, err := c.GetFilter(id)
+	if err != nil {
+		return "", err
+	}
+	return f.JQL, nil
+}
+
+type filterRequest struct {
+	Name      string `json:"name"`
+	JQL       string `json:"jql"`
+	Favourite bool   `json:"favourite"`
+}
+
+// CreateFilter creates a new saved filter using POST /filter endpoint.
+func (c *Client) CreateFilter(name, jql string, favourite bool) (*Filter, error) {
+	body, err := json.Marshal(&filterRequest{
+		Name:      name,
+		JQL:       jql,
+		Favourite: favourite,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(context.Background(), "/filter", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Filter
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// DeleteFilter deletes a saved filter using DELETE /filter/{id} endpoint.
+func (c *Client) DeleteFilter(id string) error {
+	res, err := c.DeleteV2(context.Background(), "/filter/"+id, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
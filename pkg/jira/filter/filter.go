@@ -22,6 +22,19 @@ func (flt Collection) Get(key Key) interface{} {
 	return nil
 }
 
+// GetStrings returns filter value as a slice of strings.
+func (flt Collection) GetStrings(key Key) []string {
+	for _, f := range flt {
+		if f.Key() != key {
+			continue
+		}
+		if v, ok := f.Val().([]string); ok {
+			return v
+		}
+	}
+	return nil
+}
+
 // GetInt returns filter value as an integer.
 func (flt Collection) GetInt(key Key) int {
 	for _, f := range flt {
@@ -20,3 +20,10 @@ func TestCollectionGetInt(t *testing.T) {
 	assert.Equal(t, 5, cltn.GetInt(cltn[0].Key()))
 	assert.Equal(t, 0, cltn.GetInt("unknown"))
 }
+
+func TestCollectionGetStrings(t *testing.T) {
+	cltn := filter.Collection{issue.NewNumCommentsFilter(5), issue.NewFieldsFilter("summary", "status")}
+	assert.Equal(t, []string{"summary", "status"}, cltn.GetStrings(issue.KeyIssueFields))
+	assert.Nil(t, cltn.GetStrings(issue.KeyIssueNumComments))
+	assert.Nil(t, cltn.GetStrings("unknown"))
+}
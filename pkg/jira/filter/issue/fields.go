@@ -0,0 +1,32 @@
+package issue
+
+import (
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+)
+
+// KeyIssueFields is a filter key for issue fields.
+const KeyIssueFields = filter.Key("issue-fields")
+
+// FieldsFilter is a filter for issue fields.
+type FieldsFilter struct {
+	key   filter.Key
+	value []string
+}
+
+// NewFieldsFilter constructs a filter to only fetch the given fields of an issue.
+func NewFieldsFilter(fields ...string) FieldsFilter {
+	return FieldsFilter{
+		key:   KeyIssueFields,
+		value: fields,
+	}
+}
+
+// Key returns key of this filter.
+func (ff FieldsFilter) Key() filter.Key {
+	return ff.key
+}
+
+// Val returns value of this filter.
+func (ff FieldsFilter) Val() interface{} {
+	return ff.value
+}
@@ -0,0 +1,206 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFilters(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/filter/search", r.URL.Path)
+		assert.Equal(t, "jql,favourite,owner,viewUrl,description", r.URL.Query().Get("expand"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile(fmt.Sprintf("./testdata/filters-%s.json", r.URL.Query().Get("startAt")))
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetFilters()
+	assert.NoError(t, err)
+
+	expected := []*Filter{
+		{
+			ID:        "10000",
+			Name:      "My open issues",
+			JQL:       "assignee = currentUser() AND resolution = Unresolved",
+			Favourite: true,
+			Owner:     &User{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Person A", Active: true},
+			ViewURL:   "https://test.atlassian.net/issues/?filter=10000",
+		},
+		{
+			ID:      "10001",
+			Name:    "Team bugs",
+			JQL:     "project = TEST AND type = Bug",
+			Owner:   &User{AccountID: "5b10a2844c20165700ede22h", DisplayName: "Person B", Active: true},
+			ViewURL: "https://test.atlassian.net/issues/?filter=10001",
+		},
+		{
+			ID:        "10002",
+			Name:      "Recently resolved",
+			JQL:       "resolved >= -1w",
+			Favourite: true,
+			Owner:     &User{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Person A", Active: true},
+			ViewURL:   "https://test.atlassian.net/issues/?filter=10002",
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetFilters()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetFavouriteFilters(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/favourite", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`[{"id":"10000","name":"My open issues","jql":"assignee = currentUser()","favourite":true}]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetFavouriteFilters()
+	assert.NoError(t, err)
+
+	expected := []*Filter{{ID: "10000", Name: "My open issues", JQL: "assignee = currentUser()", Favourite: true}}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetFavouriteFilters()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCreateFilter(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"name":"Team bugs","jql":"project = TEST AND type = Bug","favourite":true}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":"10001","name":"Team bugs","jql":"project = TEST AND type = Bug","favourite":true}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.CreateFilter("Team bugs", "project = TEST AND type = Bug", true)
+	assert.NoError(t, err)
+
+	expected := &Filter{ID: "10001", Name: "Team bugs", JQL: "project = TEST AND type = Bug", Favourite: true}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateFilter("Team bugs", "project = TEST AND type = Bug", true)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteFilter(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10001", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteFilter("10001")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteFilter("10001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetFilterJQL(t *testing.T) {
+	var statusCode int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10020", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if statusCode != 200 {
+			w.WriteHeader(statusCode)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":"10020","name":"Team bugs","jql":"project = TEST AND type = Bug ORDER BY created DESC"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	statusCode = 200
+
+	actual, err := client.GetFilterJQL("10020")
+	assert.NoError(t, err)
+	assert.Equal(t, "project = TEST AND type = Bug ORDER BY created DESC", actual)
+
+	statusCode = 400
+
+	_, err = client.GetFilterJQL("10020")
+	assert.ErrorIs(t, err, ErrFilterNotFound)
+
+	statusCode = 404
+
+	_, err = client.GetFilterJQL("10020")
+	assert.ErrorIs(t, err, ErrFilterNotFound)
+
+	statusCode = 500
+
+	_, err = client.GetFilterJQL("10020")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpdateIssueFixVersions adds and removes fixVersions of an issue using the update verb
+// of PUT /issue/{key} endpoint. Version names are resolved to ids using the versions of
+// the project the issue belongs to so that an unknown version results in a clear error.
+func (c *Client) UpdateIssueFixVersions(key string, add, remove []string) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(add)+len(remove))
+	for _, name := range remove {
+		names = append(names, separatorMinus+name)
+	}
+	names = append(names, add...)
+
+	project, _, _ := strings.Cut(key, "-")
+
+	ids, err := c.GetVersionIDs(project, names...)
+	if err != nil {
+		return err
+	}
+
+	req := EditRequest{FixVersions: names}
+	req.WithVersionIDs(ids)
+
+	return c.Edit(key, &req)
+}
+
+// GetVersionIDs resolves version names to ids using the versions of the project so that
+// an unknown version results in a clear error. Names prefixed with minus (-), used to
+// remove a version on edit, are resolved without the prefix.
+func (c *Client) GetVersionIDs(project string, names ...string) (map[string]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	versions, err := c.GetVersions(project)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(versions))
+	for _, v := range versions {
+		ids[v.Name] = v.ID
+	}
+
+	out := make(map[string]string, len(names))
+	for _, name := range names {
+		name = strings.TrimPrefix(name, separatorMinus)
+
+		id, ok := ids[name]
+		if !ok {
+			return nil, fmt.Errorf("version %q not found in project %s", name, project)
+		}
+		out[name] = id
+	}
+	return out, nil
+}
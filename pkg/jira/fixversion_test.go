@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetVersionIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/project/TEST/versions", r.URL.Path)
+
+		resp, err := os.ReadFile("./testdata/releases.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	ids, err := client.GetVersionIDs("TEST", "Second", "-First")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"First": "1000", "Second": "1001"}, ids)
+
+	_, err = client.GetVersionIDs("TEST", "Unknown")
+	assert.EqualError(t, err, `version "Unknown" not found in project TEST`)
+
+	ids, err = client.GetVersionIDs("TEST")
+	assert.NoError(t, err)
+	assert.Nil(t, ids)
+}
+
+func TestUpdateIssueFixVersions(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/project/TEST/versions":
+			resp, err := os.ReadFile("./testdata/releases.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		case "/rest/api/2/issue/TEST-1":
+			assert.Equal(t, "PUT", r.Method)
+
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"update":{"fixVersions":[{"remove":{"id":"1000"}},{"add":{"id":"1001"}}]},"fields":{"parent":{}}}`, string(body))
+
+			if unexpectedStatusCode {
+				w.WriteHeader(400)
+			} else {
+				w.WriteHeader(204)
+			}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueFixVersions("TEST-1", []string{"Second"}, []string{"First"})
+	assert.NoError(t, err)
+
+	err = client.UpdateIssueFixVersions("TEST-1", []string{"Unknown"}, nil)
+	assert.EqualError(t, err, `version "Unknown" not found in project TEST`)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueFixVersions("TEST-1", []string{"Second"}, []string{"First"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
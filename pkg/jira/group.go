@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Group holds info of a user group.
+type Group struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// GroupMembersResult holds response from /group/member endpoint.
+type GroupMembersResult struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	IsLast     bool    `json:"isLast"`
+	Members    []*User `json:"values"`
+}
+
+// GetUserGroups fetches names of the groups the user with the given account id
+// belongs to using v3 version of the GET /user/groups endpoint.
+func (c *Client) GetUserGroups(accountID string) ([]string, error) {
+	return c.getUserGroups(fmt.Sprintf("accountId=%s", url.QueryEscape(accountID)), apiVersion3)
+}
+
+// GetUserGroupsV2 fetches names of the groups the user with the given username
+// belongs to using v2 version of the GET /user/groups endpoint.
+func (c *Client) GetUserGroupsV2(username string) ([]string, error) {
+	// On-premise installations identify users by username instead of account id.
+	return c.getUserGroups(fmt.Sprintf("username=%s", url.QueryEscape(username)), apiVersion2)
+}
+
+func (c *Client) getUserGroups(qp string, ver string) ([]string, error) {
+	var (
+		res *http.Response
+		err error
+	)
+
+	path := fmt.Sprintf("/user/groups?%s", qp)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.GetV2(context.Background(), path, nil)
+	default:
+		res, err = c.Get(context.Background(), path, nil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []Group
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, 0, len(out))
+	for _, g := range out {
+		groups = append(groups, g.Name)
+	}
+	return groups, nil
+}
+
+// GetGroupMembers fetches all members of a group using v3 version of the
+// GET /group/member endpoint, following pagination until every member is read.
+func (c *Client) GetGroupMembers(group string) ([]*User, error) {
+	return c.getGroupMembers(group, apiVersion3)
+}
+
+// GetGroupMembersV2 is the same as GetGroupMembers but uses v2 version of the
+// GET /group/member endpoint.
+func (c *Client) GetGroupMembersV2(group string) ([]*User, error) {
+	return c.getGroupMembers(group, apiVersion2)
+}
+
+func (c *Client) getGroupMembers(group string, ver string) ([]*User, error) {
+	members := make([]*User, 0)
+
+	for {
+		path := fmt.Sprintf("/group/member?groupname=%s&startAt=%d", url.QueryEscape(group), len(members))
+
+		var (
+			res *http.Response
+			err error
+		)
+
+		switch ver {
+		case apiVersion2:
+			res, err = c.GetV2(context.Background(), path, nil)
+		default:
+			res, err = c.Get(context.Background(), path, nil)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		var out GroupMembersResult
+
+		err = func() error {
+			defer func() { _ = res.Body.Close() }()
+
+			if res.StatusCode != http.StatusOK {
+				return formatUnexpectedResponse(res)
+			}
+			return json.NewDecoder(res.Body).Decode(&out)
+		}()
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, out.Members...)
+
+		if out.IsLast || len(out.Members) == 0 || len(members) >= out.Total {
+			break
+		}
+	}
+
+	return members, nil
+}
@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserGroups(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user/groups", r.URL.Path)
+		assert.Equal(t, url.Values{"accountId": []string{"a123b"}}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"name":"developers","groupId":"g1"},{"name":"jira-users","groupId":"g2"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	groups, err := client.GetUserGroups("a123b")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"developers", "jira-users"}, groups)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetUserGroups("a123b")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetUserGroupsV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/user/groups", r.URL.Path)
+		assert.Equal(t, url.Values{"username": []string{"jane"}}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"name":"developers"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	groups, err := client.GetUserGroupsV2("jane")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"developers"}, groups)
+}
+
+func TestGetGroupMembers(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/group/member", r.URL.Path)
+		assert.Equal(t, "core devs", r.URL.Query().Get("groupname"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"isLast":false,"values":[
+				{"accountId":"a1","displayName":"Person A"},{"accountId":"a2","displayName":"Person B"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"isLast":true,"values":[
+				{"accountId":"a3","displayName":"Person C"}]}`))
+		default:
+			t.Fatalf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	members, err := client.GetGroupMembers("core devs")
+	assert.NoError(t, err)
+	assert.Len(t, members, 3)
+	assert.Equal(t, "a1", members[0].AccountID)
+	assert.Equal(t, "Person C", members[2].DisplayName)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetGroupMembers("core devs")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -3,9 +3,11 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
@@ -27,13 +29,23 @@ const (
 )
 
 // GetIssue fetches issue details using GET /issue/{key} endpoint.
+// Use issue.NewFieldsFilter to only fetch some fields of the issue.
 func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
-	iss, err := c.getIssue(key, apiVersion3)
+	return c.GetIssueCtx(context.Background(), key, opts...)
+}
+
+// GetIssueCtx is the same as GetIssue but uses the given context for the request.
+func (c *Client) GetIssueCtx(ctx context.Context, key string, opts ...filter.Filter) (*Issue, error) {
+	if c.resolveVersion(apiVersion3) == apiVersion2 {
+		return c.getIssue(ctx, key, apiVersion2, opts...)
+	}
+
+	iss, err := c.getIssue(ctx, key, apiVersion3, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	iss.Fields.Description = ifaceToADF(iss.Fields.Description)
+	iss.Fields.Description = c.toADF(iss.Fields.Description)
 
 	total := iss.Fields.Comment.Total
 	limit := filter.Collection(opts).GetInt(issue.KeyIssueNumComments)
@@ -42,18 +54,23 @@ func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
 	}
 	for i := total - 1; i >= total-limit; i-- {
 		body := iss.Fields.Comment.Comments[i].Body
-		iss.Fields.Comment.Comments[i].Body = ifaceToADF(body)
+		iss.Fields.Comment.Comments[i].Body = c.toADF(body)
 	}
 	return iss, nil
 }
 
 // GetIssueV2 fetches issue details using v2 version of Jira GET /issue/{key} endpoint.
-func (c *Client) GetIssueV2(key string, _ ...filter.Filter) (*Issue, error) {
-	return c.getIssue(key, apiVersion2)
+func (c *Client) GetIssueV2(key string, opts ...filter.Filter) (*Issue, error) {
+	return c.getIssue(context.Background(), key, apiVersion2, opts...)
 }
 
-func (c *Client) getIssue(key, ver string) (*Issue, error) {
-	rawOut, err := c.getIssueRaw(key, ver)
+// GetIssueV2Ctx is the same as GetIssueV2 but uses the given context for the request.
+func (c *Client) GetIssueV2Ctx(ctx context.Context, key string, opts ...filter.Filter) (*Issue, error) {
+	return c.getIssue(ctx, key, apiVersion2, opts...)
+}
+
+func (c *Client) getIssue(ctx context.Context, key, ver string, opts ...filter.Filter) (*Issue, error) {
+	rawOut, err := c.getIssueRaw(ctx, key, ver, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,18 +83,48 @@ func (c *Client) getIssue(key, ver string) (*Issue, error) {
 	return &iss, nil
 }
 
+// GetSubtasks fetches sub-tasks of the given parent issue. Only the summary,
+// status, priority and type of the sub-tasks are populated.
+func (c *Client) GetSubtasks(parentKey string) ([]*Issue, error) {
+	iss, err := c.getIssue(context.Background(), parentKey, apiVersion3, issue.NewFieldsFilter("subtasks"))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*Issue, 0, len(iss.Fields.Subtasks))
+	for idx := range iss.Fields.Subtasks {
+		out = append(out, &iss.Fields.Subtasks[idx])
+	}
+	return out, nil
+}
+
 // GetIssueRaw fetches issue details same as GetIssue but returns the raw API response body string.
-func (c *Client) GetIssueRaw(key string) (string, error) {
-	return c.getIssueRaw(key, apiVersion3)
+func (c *Client) GetIssueRaw(key string, opts ...filter.Filter) (string, error) {
+	return c.getIssueRaw(context.Background(), key, apiVersion3, opts...)
+}
+
+// GetIssueRawCtx is the same as GetIssueRaw but uses the given context for the request.
+func (c *Client) GetIssueRawCtx(ctx context.Context, key string, opts ...filter.Filter) (string, error) {
+	return c.getIssueRaw(ctx, key, apiVersion3, opts...)
 }
 
 // GetIssueV2Raw fetches issue details same as GetIssueV2 but returns the raw API response body string.
-func (c *Client) GetIssueV2Raw(key string) (string, error) {
-	return c.getIssueRaw(key, apiVersion2)
+func (c *Client) GetIssueV2Raw(key string, opts ...filter.Filter) (string, error) {
+	return c.getIssueRaw(context.Background(), key, apiVersion2, opts...)
 }
 
-func (c *Client) getIssueRaw(key, ver string) (string, error) {
+// GetIssueV2RawCtx is the same as GetIssueV2Raw but uses the given context for the request.
+func (c *Client) GetIssueV2RawCtx(ctx context.Context, key string, opts ...filter.Filter) (string, error) {
+	return c.getIssueRaw(ctx, key, apiVersion2, opts...)
+}
+
+func (c *Client) getIssueRaw(ctx context.Context, key, ver string, opts ...filter.Filter) (string, error) {
+	ver = c.resolveVersion(ver)
+
 	path := fmt.Sprintf("/issue/%s", key)
+	if fields := filter.Collection(opts).GetStrings(issue.KeyIssueFields); len(fields) > 0 {
+		path += fmt.Sprintf("?fields=%s", url.QueryEscape(strings.Join(fields, ",")))
+	}
 
 	var (
 		res *http.Response
@@ -86,9 +133,9 @@ func (c *Client) getIssueRaw(key, ver string) (string, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -113,15 +160,27 @@ func (c *Client) getIssueRaw(key, ver string) (string, error) {
 
 // AssignIssue assigns issue to the user using v3 version of the PUT /issue/{key}/assignee endpoint.
 func (c *Client) AssignIssue(key, assignee string) error {
-	return c.assignIssue(key, assignee, apiVersion3)
+	return c.assignIssue(context.Background(), key, assignee, apiVersion3)
+}
+
+// AssignIssueCtx is the same as AssignIssue but uses the given context for the request.
+func (c *Client) AssignIssueCtx(ctx context.Context, key, assignee string) error {
+	return c.assignIssue(ctx, key, assignee, apiVersion3)
 }
 
 // AssignIssueV2 assigns issue to the user using v2 version of the PUT /issue/{key}/assignee endpoint.
 func (c *Client) AssignIssueV2(key, assignee string) error {
-	return c.assignIssue(key, assignee, apiVersion2)
+	return c.assignIssue(context.Background(), key, assignee, apiVersion2)
+}
+
+// AssignIssueV2Ctx is the same as AssignIssueV2 but uses the given context for the request.
+func (c *Client) AssignIssueV2Ctx(ctx context.Context, key, assignee string) error {
+	return c.assignIssue(ctx, key, assignee, apiVersion2)
 }
 
-func (c *Client) assignIssue(key, assignee, ver string) error {
+func (c *Client) assignIssue(ctx context.Context, key, assignee, ver string) error {
+	ver = c.resolveVersion(ver)
+
 	path := fmt.Sprintf("/issue/%s/assignee", key)
 
 	aid := new(string)
@@ -150,7 +209,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.PutV2(context.Background(), path, body, Header{
+		res, err = c.PutV2(ctx, path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -163,7 +222,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.Put(context.Background(), path, body, Header{
+		res, err = c.Put(ctx, path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -209,6 +268,48 @@ func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
 	return out.IssueLinkTypes, nil
 }
 
+// ErrInvalidLinkType is returned when a link type or relationship
+// doesn't match any of the available issue link types.
+type ErrInvalidLinkType struct {
+	LinkType string
+	Valid    []string
+}
+
+func (e *ErrInvalidLinkType) Error() string {
+	return fmt.Sprintf(
+		"invalid issue link type %q\nAvailable issue link types are: %s",
+		e.LinkType, strings.Join(e.Valid, ", "),
+	)
+}
+
+// ResolveIssueLinkType finds the link type matching the given name, eg: "Blocks",
+// or the relationship described by the link type, eg: "blocks" or "is blocked by".
+//
+// Issues are linked such that the inward issue <outward description> the outward
+// issue, ie: ISSUE-1 blocks ISSUE-2. The returned swap flag is true if the name
+// matched the inward description of the link type in which case the inward and
+// outward issues should be swapped to preserve the requested relationship.
+func ResolveIssueLinkType(types []*IssueLinkType, name string) (*IssueLinkType, bool, error) {
+	name = strings.TrimSpace(name)
+
+	for _, t := range types {
+		if strings.EqualFold(t.Name, name) || strings.EqualFold(t.Outward, name) {
+			return t, false, nil
+		}
+	}
+	for _, t := range types {
+		if strings.EqualFold(t.Inward, name) {
+			return t, true, nil
+		}
+	}
+
+	valid := make([]string, 0, len(types))
+	for _, t := range types {
+		valid = append(valid, fmt.Sprintf("'%s' (%s / %s)", t.Name, t.Outward, t.Inward))
+	}
+	return nil, false, &ErrInvalidLinkType{LinkType: name, Valid: valid}
+}
+
 type linkRequest struct {
 	InwardIssue struct {
 		Key string `json:"key"`
@@ -219,11 +320,24 @@ type linkRequest struct {
 	LinkType struct {
 		Name string `json:"name"`
 	} `json:"type"`
+	Comment *struct {
+		Body string `json:"body"`
+	} `json:"comment,omitempty"`
 }
 
 // LinkIssue connects issues to the given link type using POST /issueLink endpoint.
 func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
-	body, err := json.Marshal(linkRequest{
+	return c.LinkIssueWithComment(inwardIssue, outwardIssue, linkType, "")
+}
+
+// LinkIssueWithComment is the same as LinkIssue but also adds a comment, eg: the reason
+// the issues are linked, as part of the same request. Comment is in markdown format.
+func (c *Client) LinkIssueWithComment(inwardIssue, outwardIssue, linkType, comment string) error {
+	return c.linkIssue(context.Background(), inwardIssue, outwardIssue, linkType, comment)
+}
+
+func (c *Client) linkIssue(ctx context.Context, inwardIssue, outwardIssue, linkType, comment string) error {
+	req := linkRequest{
 		InwardIssue: struct {
 			Key string `json:"key"`
 		}{Key: inwardIssue},
@@ -233,12 +347,19 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 		LinkType: struct {
 			Name string `json:"name"`
 		}{Name: linkType},
-	})
+	}
+	if comment != "" {
+		req.Comment = &struct {
+			Body string `json:"body"`
+		}{Body: md.ToJiraMD(comment)}
+	}
+
+	body, err := json.Marshal(req)
 	if err != nil {
 		return err
 	}
 
-	res, err := c.PostV2(context.Background(), "/issueLink", body, Header{
+	res, err := c.PostV2(ctx, "/issueLink", body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -277,23 +398,66 @@ func (c *Client) UnlinkIssue(linkID string) error {
 	return nil
 }
 
+var (
+	// ErrNoLink is returned when there is no link between two issues.
+	ErrNoLink = errors.New("no link found between provided issues")
+	// ErrHierarchyLink is returned when two issues are related as parent and sub-task.
+	// Such a relationship is part of the issue hierarchy and is not an issue link.
+	ErrHierarchyLink = errors.New("issues are related as parent and sub-task, this is an issue hierarchy and not a link")
+)
+
 // GetLinkID gets linkID between two issues.
+//
+// Links of both issues are checked so that the link is found even if it is only
+// visible from one side. ErrHierarchyLink is returned if the issues are not linked
+// but are related as parent and sub-task, ErrNoLink otherwise.
 func (c *Client) GetLinkID(inwardIssue, outwardIssue string) (string, error) {
 	i, err := c.GetIssueV2(inwardIssue)
 	if err != nil {
 		return "", err
 	}
+	if id := findLinkID(i, outwardIssue); id != "" {
+		return id, nil
+	}
+
+	o, err := c.GetIssueV2(outwardIssue)
+	if err != nil {
+		return "", err
+	}
+	if id := findLinkID(o, inwardIssue); id != "" {
+		return id, nil
+	}
 
-	for _, link := range i.Fields.IssueLinks {
-		if link.InwardIssue != nil && link.InwardIssue.Key == outwardIssue {
-			return link.ID, nil
+	if isParentOf(i, outwardIssue) || isParentOf(o, inwardIssue) {
+		return "", ErrHierarchyLink
+	}
+	return "", ErrNoLink
+}
+
+// findLinkID returns the id of the first link from the issue to the issue with the given key.
+func findLinkID(iss *Issue, key string) string {
+	for _, link := range iss.Fields.IssueLinks {
+		if link.InwardIssue != nil && link.InwardIssue.Key == key {
+			return link.ID
+		}
+		if link.OutwardIssue != nil && link.OutwardIssue.Key == key {
+			return link.ID
 		}
+	}
+	return ""
+}
 
-		if link.OutwardIssue != nil && link.OutwardIssue.Key == outwardIssue {
-			return link.ID, nil
+// isParentOf checks if the issue with the given key is a parent or a sub-task of the issue.
+func isParentOf(iss *Issue, key string) bool {
+	if iss.Fields.Parent != nil && iss.Fields.Parent.Key == key {
+		return true
+	}
+	for _, st := range iss.Fields.Subtasks {
+		if st.Key == key {
+			return true
 		}
 	}
-	return "", fmt.Errorf("no link found between provided issues")
+	return false
 }
 
 type issueCommentPropertyValue struct {
@@ -305,34 +469,142 @@ type issueCommentProperty struct {
 	Value issueCommentPropertyValue `json:"value"`
 }
 type issueCommentRequest struct {
-	Body       string                 `json:"body"`
+	Body       any                    `json:"body"` // string in v1/v2, adf.ADF in v3
+	Visibility *CommentVisibility     `json:"visibility,omitempty"`
 	Properties []issueCommentProperty `json:"properties"`
 }
 
+// Comment visibility types.
+const (
+	CommentVisibilityRole  = "role"
+	CommentVisibilityGroup = "group"
+)
+
+// CommentVisibility restricts a comment to the members of a project role or a group.
+type CommentVisibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CommentOptions holds additional options for a comment.
+type CommentOptions struct {
+	// Internal marks the comment as internal in service desk projects.
+	Internal bool
+	// Mentions maps names mentioned in the comment using @name to the users to mention.
+	Mentions map[string]*User
+	// Installation is the type of the Jira installation, ie: Cloud or Local.
+	// It defines the markup used to mention users.
+	Installation string
+	// ADF posts the comment in Atlassian document format using v3 version of the
+	// endpoint instead of the wiki markup using v2 version of the endpoint.
+	ADF bool
+	// Visibility restricts the comment to a project role or a group. Unlike Internal,
+	// that only applies to service desk projects, it works for all project types.
+	Visibility *CommentVisibility
+}
+
+// Comment holds info of a comment returned by POST /issue/{key}/comment endpoint.
+type Comment struct {
+	ID      string      `json:"id"`
+	Self    string      `json:"self"`
+	Author  User        `json:"author"`
+	Body    interface{} `json:"body"` // string in v1/v2, adf.ADF in v3
+	Created string      `json:"created"`
+}
+
 // AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
 func (c *Client) AddIssueComment(key, comment string, internal bool) error {
-	body, err := json.Marshal(&issueCommentRequest{Body: md.ToJiraMD(comment), Properties: []issueCommentProperty{{Key: "sd.public.comment", Value: issueCommentPropertyValue{Internal: internal}}}})
-	if err != nil {
-		return err
+	return c.AddIssueCommentWithOptions(context.Background(), key, comment, &CommentOptions{Internal: internal})
+}
+
+// AddIssueCommentCtx is the same as AddIssueComment but uses the given context for the request.
+func (c *Client) AddIssueCommentCtx(ctx context.Context, key, comment string, internal bool) error {
+	return c.AddIssueCommentWithOptions(ctx, key, comment, &CommentOptions{Internal: internal})
+}
+
+// AddIssueCommentWithOptions adds comment to an issue using POST /issue/{key}/comment endpoint.
+// Users mentioned in the comment using @name are replaced with the mention markup if found in opts.Mentions.
+//
+// The comment is converted from markdown to the wiki markup and posted using v2 version of the endpoint
+// unless opts.ADF is set, in which case it is converted to ADF and posted using v3 version of the endpoint.
+func (c *Client) AddIssueCommentWithOptions(ctx context.Context, key, comment string, opts *CommentOptions) error {
+	_, err := c.CreateIssueComment(ctx, key, comment, opts)
+	return err
+}
+
+// CreateIssueComment is the same as AddIssueCommentWithOptions but returns the created comment,
+// so that its id can be used to edit or delete the comment later.
+func (c *Client) CreateIssueComment(ctx context.Context, key, comment string, opts *CommentOptions) (*Comment, error) {
+	if opts == nil {
+		opts = &CommentOptions{}
 	}
 
-	path := fmt.Sprintf("/issue/%s/comment", key)
-	res, err := c.PostV2(context.Background(), path, body, Header{
-		"Accept":       "application/json",
-		"Content-Type": "application/json",
+	if opts.ADF {
+		doc, err := md.ToADF(comment)
+		if err != nil {
+			return nil, err
+		}
+		ReplaceADFMentions(doc, opts.Mentions)
+
+		return c.addIssueComment(ctx, key, doc, opts, apiVersion3)
+	}
+
+	body := ReplaceMentions(md.ToJiraMD(comment), opts.Mentions, opts.Installation)
+
+	return c.addIssueComment(ctx, key, body, opts, apiVersion2)
+}
+
+// AddIssueCommentADF adds comment in Atlassian document format to an issue
+// using v3 version of the POST /issue/{key}/comment endpoint.
+func (c *Client) AddIssueCommentADF(key string, doc *adf.ADF, internal bool) error {
+	_, err := c.addIssueComment(context.Background(), key, doc, &CommentOptions{Internal: internal}, apiVersion3)
+	return err
+}
+
+func (c *Client) addIssueComment(ctx context.Context, key string, comment any, opts *CommentOptions, ver string) (*Comment, error) {
+	body, err := json.Marshal(&issueCommentRequest{
+		Body:       comment,
+		Visibility: opts.Visibility,
+		Properties: []issueCommentProperty{{Key: "sd.public.comment", Value: issueCommentPropertyValue{Internal: opts.Internal}}},
 	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	var (
+		res    *http.Response
+		path   = fmt.Sprintf("/issue/%s/comment", key)
+		header = Header{
+			"Accept":       "application/json",
+			"Content-Type": "application/json",
+		}
+	)
+
+	switch ver {
+	case apiVersion3:
+		res, err = c.Post(ctx, path, body, header)
+	default:
+		res, err = c.PostV2(ctx, path, body, header)
+	}
+	if err != nil {
+		return nil, err
 	}
 	if res == nil {
-		return ErrEmptyResponse
+		return nil, ErrEmptyResponse
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	if res.StatusCode != http.StatusCreated {
-		return formatUnexpectedResponse(res)
+		return nil, formatUnexpectedResponse(res)
 	}
-	return nil
+
+	var out Comment
+
+	// Some servers respond without a body, the comment is created nevertheless.
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &out, nil
 }
 
 type issueWorklogRequest struct {
@@ -341,9 +613,27 @@ type issueWorklogRequest struct {
 	Comment   string `json:"comment"`
 }
 
+// Worklog holds info of a worklog returned by POST /issue/{key}/worklog endpoint.
+type Worklog struct {
+	ID               string      `json:"id"`
+	Self             string      `json:"self"`
+	Author           User        `json:"author"`
+	Comment          interface{} `json:"comment"` // string in v1/v2, adf.ADF in v3
+	Started          string      `json:"started"`
+	TimeSpent        string      `json:"timeSpent"`
+	TimeSpentSeconds int         `json:"timeSpentSeconds"`
+}
+
 // AddIssueWorklog adds worklog to an issue using POST /issue/{key}/worklog endpoint.
 // Leave param `started` empty to use the server's current datetime as start date.
 func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate string) error {
+	_, err := c.AddIssueWorklogWithResult(key, started, timeSpent, comment, newEstimate)
+	return err
+}
+
+// AddIssueWorklogWithResult is the same as AddIssueWorklog but returns the created worklog,
+// so that its id can be used to edit or delete the worklog later.
+func (c *Client) AddIssueWorklogWithResult(key, started, timeSpent, comment, newEstimate string) (*Worklog, error) {
 	worklogReq := issueWorklogRequest{
 		TimeSpent: timeSpent,
 		Comment:   md.ToJiraMD(comment),
@@ -353,7 +643,7 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 	}
 	body, err := json.Marshal(&worklogReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/issue/%s/worklog", key)
@@ -365,17 +655,24 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 		"Content-Type": "application/json",
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if res == nil {
-		return ErrEmptyResponse
+		return nil, ErrEmptyResponse
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	if res.StatusCode != http.StatusCreated {
-		return formatUnexpectedResponse(res)
+		return nil, formatUnexpectedResponse(res)
 	}
-	return nil
+
+	var out Worklog
+
+	// Some servers respond without a body, the worklog is created nevertheless.
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &out, nil
 }
 
 // GetField gets all fields configured for a Jira instance using GET /field endpiont.
@@ -403,39 +700,61 @@ func (c *Client) GetField() ([]*Field, error) {
 	return out, err
 }
 
-func ifaceToADF(v interface{}) *adf.ADF {
+// toADF converts the value to an ADF document. Content that can't be parsed as ADF is
+// returned as a raw string instead so that it is still displayed, albeit roughly.
+func (c *Client) toADF(v interface{}) interface{} {
+	doc, err := ifaceToADF(v)
+	if err != nil {
+		if c.trace != nil {
+			_, _ = fmt.Fprintf(c.trace, "unable to parse ADF, falling back to raw content: %s\n", err)
+		}
+		return ifaceToString(v)
+	}
+	return doc
+}
+
+func ifaceToADF(v interface{}) (*adf.ADF, error) {
 	if v == nil {
-		return nil
+		return nil, nil
 	}
 
 	var doc *adf.ADF
 
 	js, err := json.Marshal(v)
 	if err != nil {
-		return nil // ignore invalid data
+		return nil, err
 	}
 	if err = json.Unmarshal(js, &doc); err != nil {
-		return nil // ignore invalid data
+		return nil, err
 	}
 
-	return doc
+	return doc, nil
 }
 
-type remotelinkRequest struct {
-	RemoteObject struct {
-		URL   string `json:"url"`
-		Title string `json:"title"`
-	} `json:"object"`
+func ifaceToString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if js, err := json.Marshal(v); err == nil {
+		return string(js)
+	}
+	return fmt.Sprintf("%v", v)
 }
 
 // RemoteLinkIssue adds a remote link to an issue using POST /issue/{issueId}/remotelink endpoint.
 func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
-	body, err := json.Marshal(remotelinkRequest{
-		RemoteObject: struct {
-			URL   string `json:"url"`
-			Title string `json:"title"`
-		}{Title: title, URL: url},
+	return c.AddRemoteLink(issueID, &RemoteLink{
+		Object: RemoteLinkObject{URL: url, Title: title},
 	})
+}
+
+// AddRemoteLink adds a remote link with additional details like icon, relationship,
+// status and application to an issue using POST /issue/{issueId}/remotelink endpoint.
+//
+// Jira updates the existing link instead of creating a new one if a link with the same
+// GlobalID already exists on the issue, so setting it makes the call safe to repeat.
+func (c *Client) AddRemoteLink(issueID string, link *RemoteLink) error {
+	body, err := json.Marshal(link)
 	if err != nil {
 		return err
 	}
@@ -454,23 +773,103 @@ func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
 	}
 	defer func() { _ = res.Body.Close() }()
 
-	if res.StatusCode != http.StatusCreated {
+	// Updating an existing link by its global id responds with 200.
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
 		return formatUnexpectedResponse(res)
 	}
 	return nil
 }
 
-// WatchIssue adds user as a watcher using v2 version of the POST /issue/{key}/watchers endpoint.
+// GetRemoteLinkByGlobalID fetches the remote link with the given global id using
+// GET /issue/{issueId}/remotelink?globalId={globalId} endpoint.
+func (c *Client) GetRemoteLinkByGlobalID(key, globalID string) (*RemoteLink, error) {
+	path := fmt.Sprintf("/issue/%s/remotelink?globalId=%s", key, url.QueryEscape(globalID))
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out RemoteLink
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetRemoteLinks fetches remote links of an issue using GET /issue/{issueId}/remotelink endpoint.
+func (c *Client) GetRemoteLinks(key string) ([]*RemoteLink, error) {
+	path := fmt.Sprintf("/issue/%s/remotelink", key)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*RemoteLink
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// DeleteRemoteLink deletes a remote link from an issue using DELETE /issue/{issueId}/remotelink/{linkId} endpoint.
+func (c *Client) DeleteRemoteLink(key, remoteLinkID string) error {
+	path := fmt.Sprintf("/issue/%s/remotelink/%s", key, remoteLinkID)
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// WatchIssue adds user as a watcher using v3 version of the POST /issue/{key}/watchers endpoint.
+// The current user is added if watcher is empty.
 func (c *Client) WatchIssue(key, watcher string) error {
-	return c.watchIssue(key, watcher, apiVersion3)
+	return c.watchIssue(context.Background(), key, watcher, apiVersion3)
+}
+
+// WatchIssueCtx is the same as WatchIssue but uses the given context for the request.
+func (c *Client) WatchIssueCtx(ctx context.Context, key, watcher string) error {
+	return c.watchIssue(ctx, key, watcher, apiVersion3)
 }
 
 // WatchIssueV2 adds user as a watcher using using v2 version of the POST /issue/{key}/watchers endpoint.
 func (c *Client) WatchIssueV2(key, watcher string) error {
-	return c.watchIssue(key, watcher, apiVersion2)
+	return c.watchIssue(context.Background(), key, watcher, apiVersion2)
+}
+
+// WatchIssueV2Ctx is the same as WatchIssueV2 but uses the given context for the request.
+func (c *Client) WatchIssueV2Ctx(ctx context.Context, key, watcher string) error {
+	return c.watchIssue(ctx, key, watcher, apiVersion2)
 }
 
-func (c *Client) watchIssue(key, watcher, ver string) error {
+func (c *Client) watchIssue(ctx context.Context, key, watcher, ver string) error {
 	path := fmt.Sprintf("/issue/%s/watchers", key)
 
 	var (
@@ -479,9 +878,12 @@ func (c *Client) watchIssue(key, watcher, ver string) error {
 		body []byte
 	)
 
-	body, err = json.Marshal(watcher)
-	if err != nil {
-		return err
+	// Request without a body adds the current user to watchers.
+	if watcher != "" {
+		body, err = json.Marshal(watcher)
+		if err != nil {
+			return err
+		}
 	}
 
 	header := Header{
@@ -491,9 +893,58 @@ func (c *Client) watchIssue(key, watcher, ver string) error {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), path, body, header)
+		res, err = c.PostV2(ctx, path, body, header)
+	default:
+		res, err = c.Post(ctx, path, body, header)
+	}
+
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// UnwatchIssue removes user from issue watchers using v3 version of the DELETE /issue/{key}/watchers endpoint.
+func (c *Client) UnwatchIssue(key, watcher string) error {
+	return c.unwatchIssue(context.Background(), key, watcher, apiVersion3)
+}
+
+// UnwatchIssueCtx is the same as UnwatchIssue but uses the given context for the request.
+func (c *Client) UnwatchIssueCtx(ctx context.Context, key, watcher string) error {
+	return c.unwatchIssue(ctx, key, watcher, apiVersion3)
+}
+
+// UnwatchIssueV2 removes user from issue watchers using v2 version of the DELETE /issue/{key}/watchers endpoint.
+func (c *Client) UnwatchIssueV2(key, watcher string) error {
+	return c.unwatchIssue(context.Background(), key, watcher, apiVersion2)
+}
+
+// UnwatchIssueV2Ctx is the same as UnwatchIssueV2 but uses the given context for the request.
+func (c *Client) UnwatchIssueV2Ctx(ctx context.Context, key, watcher string) error {
+	return c.unwatchIssue(ctx, key, watcher, apiVersion2)
+}
+
+func (c *Client) unwatchIssue(ctx context.Context, key, watcher, ver string) error {
+	var (
+		res *http.Response
+		err error
+	)
+
+	switch ver {
+	case apiVersion2:
+		path := fmt.Sprintf("/issue/%s/watchers?username=%s", key, url.QueryEscape(watcher))
+		res, err = c.DeleteV2(ctx, path, nil)
 	default:
-		res, err = c.Post(context.Background(), path, body, header)
+		path := fmt.Sprintf("/issue/%s/watchers?accountId=%s", key, url.QueryEscape(watcher))
+		res, err = c.Delete(ctx, path, nil)
 	}
 
 	if err != nil {
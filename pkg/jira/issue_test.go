@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
 )
 
 const (
@@ -71,9 +73,7 @@ func TestGetIssue(t *testing.T) {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 			IssueLinks: []struct {
@@ -141,9 +141,7 @@ func TestGetIssueWithoutDescription(t *testing.T) {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 		},
@@ -192,9 +190,7 @@ func TestGetIssueV2(t *testing.T) {
 				IsWatching bool `json:"isWatching"`
 				WatchCount int  `json:"watchCount"`
 			}{IsWatching: true, WatchCount: 1},
-			Status: struct {
-				Name string `json:"name"`
-			}{Name: "To Do"},
+			Status:  IssueStatus{Name: "To Do"},
 			Created: "2020-12-03T14:05:20.974+0100",
 			Updated: "2020-12-03T14:05:20.974+0100",
 		},
@@ -347,6 +343,32 @@ func TestGetIssueRaw(t *testing.T) {
 	}
 }
 
+func TestGetIssueWithFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "summary,status", r.URL.Query().Get("fields"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{"summary":"Bug summary","status":{"name":"To Do"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	iss, err := client.GetIssue("TEST-1", issue.NewNumCommentsFilter(1), issue.NewFieldsFilter("summary", "status"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Bug summary", iss.Fields.Summary)
+	assert.Equal(t, "To Do", iss.Fields.Status.Name)
+
+	iss, err = client.GetIssueV2("TEST-1", issue.NewFieldsFilter("summary", "status"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Bug summary", iss.Fields.Summary)
+
+	raw, err := client.GetIssueRaw("TEST-1", issue.NewFieldsFilter("summary", "status"))
+	assert.NoError(t, err)
+	assert.Contains(t, raw, `"summary":"Bug summary"`)
+}
+
 func TestAssignIssue(t *testing.T) {
 	var (
 		apiVersion2          bool
@@ -465,6 +487,31 @@ func TestLinkIssue(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestLinkIssueWithComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issueLink", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{
+			"inwardIssue": {"key": "TEST-1"},
+			"outwardIssue": {"key": "TEST-2"},
+			"type": {"name": "Blocks"},
+			"comment": {"body": "per discussion"}
+		}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.LinkIssueWithComment("TEST-1", "TEST-2", "Blocks", "per discussion")
+	assert.NoError(t, err)
+}
+
 func TestUnlinkIssue(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -495,14 +542,25 @@ func TestUnlinkIssue(t *testing.T) {
 
 func TestGetLinkID(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
 
-		resp, err := os.ReadFile(_testdataPathIssue)
-		assert.NoError(t, err)
+		switch r.URL.Path {
+		case "/rest/api/2/issue/TEST-1":
+			resp, err := os.ReadFile(_testdataPathIssue)
+			assert.NoError(t, err)
 
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(200)
-		_, _ = w.Write(resp)
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		case "/rest/api/2/issue/TEST-3":
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"key":"TEST-3","fields":{"issuelinks":[{"id":"10005","inwardIssue":{"key":"TEST-1"}}]}}`))
+		case "/rest/api/2/issue/TEST-4":
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"key":"TEST-4","fields":{"parent":{"key":"TEST-1"}}}`))
+		default:
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"key":"TEST-1234","fields":{}}`))
+		}
 	}))
 	defer server.Close()
 
@@ -514,8 +572,15 @@ func TestGetLinkID(t *testing.T) {
 	expected := "10001"
 	assert.Equal(t, expected, actual)
 
+	actual, err = client.GetLinkID("TEST-1", "TEST-3")
+	assert.NoError(t, err)
+	assert.Equal(t, "10005", actual)
+
+	_, err = client.GetLinkID("TEST-1", "TEST-4")
+	assert.ErrorIs(t, err, ErrHierarchyLink)
+
 	_, err = client.GetLinkID("TEST-1", "TEST-1234")
-	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrNoLink)
 	assert.Equal(t, "no link found between provided issues", err.Error())
 }
 
@@ -608,6 +673,65 @@ func TestAddIssueWorklog(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestGetSubtasks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "subtasks", r.URL.Query().Get("fields"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"key": "TEST-1",
+			"fields": {
+				"subtasks": [
+					{"key": "TEST-2", "fields": {"summary": "First", "status": {"name": "Done"}}},
+					{"key": "TEST-3", "fields": {"summary": "Second", "status": {"name": "To Do"}}}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSubtasks("TEST-1")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "TEST-2", actual[0].Key)
+	assert.Equal(t, "Done", actual[0].Fields.Status.Name)
+	assert.Equal(t, "Second", actual[1].Fields.Summary)
+	assert.Equal(t, "To Do", actual[1].Fields.Status.Name)
+}
+
+func TestAddIssueWorklogWithResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{
+			"id": "10100",
+			"self": "https://test.atlassian.net/rest/api/2/issue/10010/worklog/10100",
+			"author": {"accountId": "a123", "displayName": "Person A"},
+			"comment": "comment",
+			"started": "2022-01-01T01:02:02.000+0200",
+			"timeSpent": "1h",
+			"timeSpentSeconds": 3600
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	worklog, err := client.AddIssueWorklogWithResult("TEST-1", "2022-01-01T01:02:02.000+0200", "1h", "comment", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "10100", worklog.ID)
+	assert.Equal(t, "Person A", worklog.Author.DisplayName)
+	assert.Equal(t, "2022-01-01T01:02:02.000+0200", worklog.Started)
+	assert.Equal(t, 3600, worklog.TimeSpentSeconds)
+}
+
 func TestGetField(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -645,6 +769,8 @@ func TestGetField(t *testing.T) {
 				DataType: "array",
 				Items:    "version",
 			},
+			Searchable:  true,
+			ClauseNames: []string{"fixVersion"},
 		},
 		{
 			ID:     "customfield_10111",
@@ -658,6 +784,9 @@ func TestGetField(t *testing.T) {
 				DataType: "number",
 				FieldID:  10111,
 			},
+			Searchable:  true,
+			ClauseNames: []string{"cf[10111]", "Original story points"},
+			SearcherKey: "com.atlassian.jira.plugin.system.customfieldtypes:exactnumber",
 		},
 		{
 			ID:     "timespent",
@@ -670,6 +799,7 @@ func TestGetField(t *testing.T) {
 			}{
 				DataType: "number",
 			},
+			ClauseNames: []string{"timespent"},
 		},
 	}
 	assert.Equal(t, expected, actual)
@@ -680,6 +810,26 @@ func TestGetField(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestFieldJQLClause(t *testing.T) {
+	cases := []struct {
+		field    Field
+		expected string
+	}{
+		{field: Field{ID: "fixVersions", ClauseNames: []string{"fixVersion"}}, expected: "fixVersion"},
+		{field: Field{ID: "customfield_10111", Custom: true, ClauseNames: []string{"Story Points", "cf[10111]"}}, expected: "cf[10111]"},
+		{field: Field{ID: "customfield_10112", Custom: true, ClauseNames: []string{"Story Points"}}, expected: `"Story Points"`},
+		{field: Field{ID: "timespent"}, expected: "timespent"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, tc.field.JQLClause())
+	}
+
+	custom := Field{ID: "customfield_10113", Custom: true}
+	custom.Schema.FieldID = 10113
+	assert.Equal(t, "cf[10113]", custom.JQLClause())
+}
+
 func TestRemoteLinkIssue(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -708,6 +858,280 @@ func TestRemoteLinkIssue(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestAddIssueCommentWithMentions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"body":"[~accountid:a12b3] please review, @unknown","properties":[{"key":"sd.public.comment","value":{"internal":true}}]}`
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddIssueCommentWithOptions(context.Background(), "TEST-1", "@alice please review, @unknown", &CommentOptions{
+		Internal:     true,
+		Mentions:     map[string]*User{"alice": {AccountID: "a12b3"}},
+		Installation: InstallationTypeCloud,
+	})
+	assert.NoError(t, err)
+}
+
+func TestAddIssueCommentWithVisibility(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"body":"comment","visibility":{"type":"role","value":"Developers"},"properties":[{"key":"sd.public.comment","value":{"internal":false}}]}`
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddIssueCommentWithOptions(context.Background(), "TEST-1", "comment", &CommentOptions{
+		Visibility: &CommentVisibility{Type: CommentVisibilityRole, Value: "Developers"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestAddIssueCommentADF(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/comment", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{
+			"body": {
+				"version": 1,
+				"type": "doc",
+				"content": [{"type": "paragraph", "content": [
+					{"type": "mention", "attrs": {"id": "a12b3", "text": "@Alice"}},
+					{"type": "text", "text": " take a look"}
+				]}]
+			},
+			"properties": [{"key": "sd.public.comment", "value": {"internal": false}}]
+		}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(201)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddIssueCommentWithOptions(context.Background(), "TEST-1", "@alice take a look", &CommentOptions{
+		ADF:      true,
+		Mentions: map[string]*User{"alice": {AccountID: "a12b3", DisplayName: "Alice"}},
+	})
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	doc := &adf.ADF{
+		Version: 1,
+		DocType: "doc",
+		Content: []*adf.Node{{NodeType: adf.NodeParagraph, Content: []*adf.Node{
+			{NodeType: adf.InlineNodeMention, Attributes: map[string]any{"id": "a12b3", "text": "@Alice"}},
+			{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: " take a look"}},
+		}}},
+	}
+	err = client.AddIssueCommentADF("TEST-1", doc, false)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddRemoteLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{
+			"globalId": "github=org/repo/pull/1",
+			"application": {"type": "com.github", "name": "GitHub"},
+			"relationship": "implemented by",
+			"object": {
+				"url": "https://github.com/org/repo/pull/1",
+				"title": "PR #1",
+				"icon": {"url16x16": "https://github.com/favicon.ico", "title": "GitHub"},
+				"status": {"resolved": true}
+			}
+		}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddRemoteLink("TEST-1", &RemoteLink{
+		GlobalID:     "github=org/repo/pull/1",
+		Application:  &RemoteLinkApplication{Type: "com.github", Name: "GitHub"},
+		Relationship: "implemented by",
+		Object: RemoteLinkObject{
+			URL:    "https://github.com/org/repo/pull/1",
+			Title:  "PR #1",
+			Icon:   &RemoteLinkIcon{URL: "https://github.com/favicon.ico", Title: "GitHub"},
+			Status: &RemoteLinkStatus{Resolved: true},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestAddRemoteLinkUpdatesExisting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+
+		// Jira responds with 200 if a link with the same global id is updated.
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": 10000, "self": "https://test.atlassian.net/rest/api/2/issue/TEST-1/remotelink/10000"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddRemoteLink("TEST-1", &RemoteLink{
+		GlobalID: "ci=build/42",
+		Object:   RemoteLinkObject{URL: "https://ci.example.com/build/42", Title: "Build #42"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetRemoteLinkByGlobalID(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "system=https://github.com/org/repo/pull/1", r.URL.Query().Get("globalId"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{
+				"id": 10000,
+				"globalId": "system=https://github.com/org/repo/pull/1",
+				"object": {"url": "https://github.com/org/repo/pull/1", "title": "PR #1"}
+			}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetRemoteLinkByGlobalID("TEST-1", "system=https://github.com/org/repo/pull/1")
+	assert.NoError(t, err)
+	assert.Equal(t, 10000, actual.ID)
+	assert.Equal(t, "PR #1", actual.Object.Title)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetRemoteLinkByGlobalID("TEST-1", "system=https://github.com/org/repo/pull/1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetRemoteLinks(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/remotelinks.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetRemoteLinks("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*RemoteLink{
+		{
+			ID:           10000,
+			GlobalID:     "system=https://github.com/org/repo/pull/1",
+			Application:  &RemoteLinkApplication{Type: "com.github", Name: "GitHub"},
+			Relationship: "implemented by",
+			Object: RemoteLinkObject{
+				URL:    "https://github.com/org/repo/pull/1",
+				Title:  "PR #1",
+				Icon:   &RemoteLinkIcon{URL: "https://github.com/favicon.ico", Title: "GitHub"},
+				Status: &RemoteLinkStatus{Resolved: true},
+			},
+		},
+		{
+			ID:     10001,
+			Object: RemoteLinkObject{URL: "https://weblink.com", Title: "weblink title"},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetRemoteLinks("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteRemoteLink(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink/10000", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteRemoteLink("TEST-1", "10000")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteRemoteLink("TEST-1", "10000")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
 func TestWatchIssue(t *testing.T) {
 	var (
 		apiVersion2          bool
@@ -745,3 +1169,156 @@ func TestWatchIssue(t *testing.T) {
 	err = client.WatchIssueV2("TEST-1", "a12b3")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestWatchIssueAsCurrentUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/watchers", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Empty(t, body)
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.WatchIssue("TEST-1", "")
+	assert.NoError(t, err)
+}
+
+func TestUnwatchIssue(t *testing.T) {
+	var (
+		apiVersion2          bool
+		unexpectedStatusCode bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+
+		if apiVersion2 {
+			assert.Equal(t, "/rest/api/2/issue/TEST-1/watchers", r.URL.Path)
+			assert.Equal(t, "jon", r.URL.Query().Get("username"))
+		} else {
+			assert.Equal(t, "/rest/api/3/issue/TEST-1/watchers", r.URL.Path)
+			assert.Equal(t, "a12b3", r.URL.Query().Get("accountId"))
+		}
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UnwatchIssue("TEST-1", "a12b3")
+	assert.NoError(t, err)
+
+	apiVersion2 = true
+
+	err = client.UnwatchIssueV2("TEST-1", "jon")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UnwatchIssueV2("TEST-1", "jon")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestResolveIssueLinkType(t *testing.T) {
+	types := []*IssueLinkType{
+		{ID: "10000", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+		{ID: "10001", Name: "Cloners", Inward: "is cloned by", Outward: "clones"},
+		{ID: "10002", Name: "Relates", Inward: "relates to", Outward: "relates to"},
+	}
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+		swap     bool
+	}{
+		{name: "by name", input: "blocks", expected: "Blocks", swap: false},
+		{name: "by outward description", input: "clones", expected: "Cloners", swap: false},
+		{name: "by inward description", input: "Is Blocked By", expected: "Blocks", swap: true},
+		{name: "symmetric relationship", input: "relates to", expected: "Relates", swap: false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			lt, swap, err := ResolveIssueLinkType(types, tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, lt.Name)
+			assert.Equal(t, tc.swap, swap)
+		})
+	}
+
+	_, _, err := ResolveIssueLinkType(types, "duplicates")
+	assert.Equal(
+		t,
+		"invalid issue link type \"duplicates\"\nAvailable issue link types are: "+
+			"'Blocks' (blocks / is blocked by), 'Cloners' (clones / is cloned by), 'Relates' (relates to / relates to)",
+		err.Error(),
+	)
+}
+
+func TestGetIssueWithInvalidADF(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"key": "TEST-1",
+			"fields": {
+				"description": {"type": "doc", "content": "unexpected"},
+				"comment": {"total": 1, "comments": [{"body": "plain comment"}]}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	var trace strings.Builder
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithDebug(&trace))
+
+	actual, err := client.GetIssue("TEST-1", issue.NewNumCommentsFilter(1))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"content":"unexpected","type":"doc"}`, actual.Fields.Description)
+	assert.Equal(t, "plain comment", actual.Fields.Comment.Comments[0].Body)
+	assert.Contains(t, trace.String(), "unable to parse ADF, falling back to raw content")
+}
+
+func TestCreateIssueComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{
+			"id": "10042",
+			"self": "https://test.atlassian.net/rest/api/2/issue/10010/comment/10042",
+			"author": {"displayName": "Person A"},
+			"body": "comment",
+			"created": "2020-12-03T14:05:20.974+0100"
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.CreateIssueComment(context.Background(), "TEST-1", "comment", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &Comment{
+		ID:      "10042",
+		Self:    "https://test.atlassian.net/rest/api/2/issue/10010/comment/10042",
+		Author:  User{DisplayName: "Person A"},
+		Body:    "comment",
+		Created: "2020-12-03T14:05:20.974+0100",
+	}, actual)
+}
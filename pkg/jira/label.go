@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// LabelResult holds response from /label endpoint.
+type LabelResult struct {
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Labels     []string `json:"values"`
+}
+
+// GetAvailableLabels fetches labels used in the instance using GET /label endpoint, following
+// pagination until every label is read. Only labels containing the query are returned, matched
+// case-insensitively, an empty query returns all labels. The endpoint is only available in Jira cloud.
+func (c *Client) GetAvailableLabels(query string) ([]string, error) {
+	var (
+		labels []string
+		read   int
+	)
+
+	query = strings.ToLower(query)
+
+	for {
+		res, err := c.Get(context.Background(), fmt.Sprintf("/label?startAt=%d", read), nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		var out LabelResult
+
+		err = func() error {
+			defer func() { _ = res.Body.Close() }()
+
+			if res.StatusCode != http.StatusOK {
+				return formatUnexpectedResponse(res)
+			}
+			return json.NewDecoder(res.Body).Decode(&out)
+		}()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range out.Labels {
+			if strings.Contains(strings.ToLower(l), query) {
+				labels = append(labels, l)
+			}
+		}
+		read += len(out.Labels)
+
+		if out.IsLast || len(out.Labels) == 0 || read >= out.Total {
+			break
+		}
+	}
+
+	return labels, nil
+}
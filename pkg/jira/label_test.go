@@ -0,0 +1,51 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAvailableLabels(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/label", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":3,"total":4,"isLast":false,"values":["backend","Frontend","urgent"]}`))
+		case "3":
+			_, _ = w.Write([]byte(`{"startAt":3,"maxResults":3,"total":4,"isLast":true,"values":["frontend-ux"]}`))
+		default:
+			t.Fatalf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	labels, err := client.GetAvailableLabels("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"backend", "Frontend", "urgent", "frontend-ux"}, labels)
+
+	labels, err = client.GetAvailableLabels("front")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Frontend", "frontend-ux"}, labels)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetAvailableLabels("")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
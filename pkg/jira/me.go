@@ -8,10 +8,11 @@ import (
 
 // Me struct holds response from /myself endpoint.
 type Me struct {
-	Login    string `json:"name"`
-	Name     string `json:"displayName"`
-	Email    string `json:"emailAddress"`
-	Timezone string `json:"timeZone"`
+	AccountID string `json:"accountId,omitempty"`
+	Login     string `json:"name"`
+	Name      string `json:"displayName"`
+	Email     string `json:"emailAddress"`
+	Timezone  string `json:"timeZone"`
 }
 
 // Me fetches response from /myself endpoint.
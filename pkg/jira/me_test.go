@@ -35,8 +35,9 @@ func TestMe(t *testing.T) {
 	assert.NoError(t, err)
 
 	expected := &Me{
-		Name:  "Person A",
-		Email: "user@test.com",
+		AccountID: "a12b3",
+		Name:      "Person A",
+		Email:     "user@test.com",
 	}
 	assert.Equal(t, expected, actual)
 
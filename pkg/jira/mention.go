@@ -0,0 +1,144 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+// mentionRegex matches @name mentions that are not part of a word, eg: an email.
+var mentionRegex = regexp.MustCompile(`(^|[\s(\[{>,;])@([\w][\w.\-]*)`)
+
+// ParseMentions returns unique names mentioned in the text using @name syntax in the order they appear.
+func ParseMentions(text string) []string {
+	var (
+		names []string
+		seen  = make(map[string]struct{})
+	)
+
+	for _, m := range mentionRegex.FindAllStringSubmatch(text, -1) {
+		name := strings.TrimRight(m[2], ".-")
+		if _, ok := seen[name]; ok || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
+
+// MentionMarkup returns the wiki markup to mention the user. Local installations
+// mention users by username whereas cloud installations use the account id.
+func MentionMarkup(u *User, installation string) string {
+	if installation == InstallationTypeLocal {
+		return fmt.Sprintf("[~%s]", u.Name)
+	}
+	return fmt.Sprintf("[~accountid:%s]", u.AccountID)
+}
+
+// ReplaceMentions replaces @name mentions in the text with the wiki markup mention of
+// the matching user. Mentions without a matching user are left untouched.
+func ReplaceMentions(text string, users map[string]*User, installation string) string {
+	if len(users) == 0 {
+		return text
+	}
+
+	return mentionRegex.ReplaceAllStringFunc(text, func(match string) string {
+		m := mentionRegex.FindStringSubmatch(match)
+		name := strings.TrimRight(m[2], ".-")
+
+		u, ok := users[name]
+		if !ok || u == nil {
+			return match
+		}
+		return m[1] + MentionMarkup(u, installation) + strings.TrimPrefix(m[2], name)
+	})
+}
+
+// ReplaceADFMentions replaces @name mentions in text nodes of the document with
+// the mention node of the matching user. Text inside code is left untouched.
+func ReplaceADFMentions(doc *adf.ADF, users map[string]*User) {
+	if doc == nil || len(users) == 0 {
+		return
+	}
+	for _, n := range doc.Content {
+		replaceADFMentions(n, users)
+	}
+}
+
+func replaceADFMentions(n *adf.Node, users map[string]*User) {
+	if n.NodeType == adf.NodeCodeBlock || len(n.Content) == 0 {
+		return
+	}
+
+	content := make([]*adf.Node, 0, len(n.Content))
+	for _, child := range n.Content {
+		if child.NodeType != adf.ChildNodeText || hasCodeMark(child) {
+			replaceADFMentions(child, users)
+			content = append(content, child)
+			continue
+		}
+		content = append(content, splitMentions(child, users)...)
+	}
+	n.Content = content
+}
+
+// splitMentions splits the text node into text and mention nodes.
+func splitMentions(n *adf.Node, users map[string]*User) []*adf.Node {
+	var (
+		out  []*adf.Node
+		text = n.Text
+		last int
+	)
+
+	textNode := func(t string) *adf.Node {
+		return &adf.Node{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: t, Marks: n.Marks}}
+	}
+
+	for _, idx := range mentionRegex.FindAllStringSubmatchIndex(text, -1) {
+		// idx[4]:idx[5] is the name without @.
+		name := strings.TrimRight(text[idx[4]:idx[5]], ".-")
+
+		u, ok := users[name]
+		if !ok || u == nil {
+			continue
+		}
+
+		start := idx[4] - 1 // Position of @.
+		if start > last {
+			out = append(out, textNode(text[last:start]))
+		}
+
+		label := u.DisplayName
+		if label == "" {
+			label = name
+		}
+		out = append(out, &adf.Node{
+			NodeType: adf.InlineNodeMention,
+			Attributes: map[string]any{
+				"id":   u.AccountID,
+				"text": "@" + label,
+			},
+		})
+		last = idx[4] + len(name)
+	}
+
+	if last == 0 {
+		return []*adf.Node{n}
+	}
+	if last < len(text) {
+		out = append(out, textNode(text[last:]))
+	}
+	return out
+}
+
+func hasCodeMark(n *adf.Node) bool {
+	for _, m := range n.Marks {
+		if m.MarkType == adf.MarkCode {
+			return true
+		}
+	}
+	return false
+}
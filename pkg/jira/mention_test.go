@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+func TestParseMentions(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "no mentions", input: "Nothing to see here", expected: nil},
+		{name: "single mention", input: "@alice please review", expected: []string{"alice"}},
+		{
+			name:     "multiple mentions",
+			input:    "Thanks @alice, and (@bob.smith). cc @alice @carol-d.",
+			expected: []string{"alice", "bob.smith", "carol-d"},
+		},
+		{name: "email is not a mention", input: "Mail me at alice@example.com", expected: nil},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseMentions(tc.input))
+		})
+	}
+}
+
+func TestReplaceMentions(t *testing.T) {
+	users := map[string]*User{
+		"alice": {AccountID: "a12b3", Name: "alice"},
+		"bob":   {AccountID: "b45c6", Name: "bob.smith"},
+	}
+
+	text := "Hi @alice and @bob. Ping @carol, mail alice@example.com"
+
+	assert.Equal(
+		t,
+		"Hi [~accountid:a12b3] and [~accountid:b45c6]. Ping @carol, mail alice@example.com",
+		ReplaceMentions(text, users, InstallationTypeCloud),
+	)
+	assert.Equal(
+		t,
+		"Hi [~alice] and [~bob.smith]. Ping @carol, mail alice@example.com",
+		ReplaceMentions(text, users, InstallationTypeLocal),
+	)
+	assert.Equal(t, text, ReplaceMentions(text, nil, InstallationTypeCloud))
+}
+
+func TestReplaceADFMentions(t *testing.T) {
+	users := map[string]*User{
+		"alice": {AccountID: "a12b3", DisplayName: "Alice"},
+	}
+
+	doc := &adf.ADF{
+		Version: 1,
+		DocType: "doc",
+		Content: []*adf.Node{
+			{
+				NodeType: adf.NodeParagraph,
+				Content: []*adf.Node{
+					{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: "Hi @alice. Ping @bob"}},
+					{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: "@alice", Marks: []adf.MarkNode{{MarkType: adf.MarkCode}}}},
+				},
+			},
+		},
+	}
+
+	ReplaceADFMentions(doc, users)
+
+	expected := []*adf.Node{
+		{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: "Hi "}},
+		{NodeType: adf.InlineNodeMention, Attributes: map[string]any{"id": "a12b3", "text": "@Alice"}},
+		{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: ". Ping @bob"}},
+		{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: "@alice", Marks: []adf.MarkNode{{MarkType: adf.MarkCode}}}},
+	}
+	assert.Equal(t, expected, doc.Content[0].Content)
+}
@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type parentRequest struct {
+	Fields struct {
+		Parent *struct {
+			Key string `json:"key"`
+		} `json:"parent"`
+	} `json:"fields"`
+}
+
+// SetIssueParent sets the parent of an issue using the parent field of PUT /issue/{key} endpoint.
+// It works for sub-tasks in classic projects as well as any level of hierarchy, eg: a story
+// under an epic, in team-managed projects where it replaces the epic link.
+func (c *Client) SetIssueParent(key, parentKey string) error {
+	var req parentRequest
+
+	req.Fields.Parent = &struct {
+		Key string `json:"key"`
+	}{Key: parentKey}
+
+	return c.updateParent(key, &req)
+}
+
+// ClearIssueParent removes the parent of an issue.
+func (c *Client) ClearIssueParent(key string) error {
+	return c.updateParent(key, &parentRequest{})
+}
+
+func (c *Client) updateParent(key string, req *parentRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV2(context.Background(), c.withNotify("/issue/"+key, false), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIssueParent(t *testing.T) {
+	var (
+		body                 string
+		unexpectedStatusCode bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.SetIssueParent("TEST-1", "TEST-2")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":{"parent":{"key":"TEST-2"}}}`, body)
+
+	err = client.ClearIssueParent("TEST-1")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":{"parent":null}}`, body)
+
+	unexpectedStatusCode = true
+
+	err = client.SetIssueParent("TEST-1", "TEST-2")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,21 @@
+package jira
+
+import "context"
+
+// ProgressFunc receives the number of processed items and the total
+// number of items of an operation on multiple issues.
+type ProgressFunc func(done, total int)
+
+type progressKey struct{}
+
+// WithProgress returns a copy of ctx that reports progress of
+// bulk and rank operations started with it to the given func.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+func reportProgress(ctx context.Context, done, total int) {
+	if fn, ok := ctx.Value(progressKey{}).(ProgressFunc); ok && fn != nil {
+		fn(done, total)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 const (
@@ -34,3 +36,76 @@ func (c *Client) Project() ([]*Project, error) {
 
 	return out, err
 }
+
+// GetProject fetches details of a project including its components, versions,
+// issue types and roles using GET /project/{projectIdOrKey} endpoint.
+func (c *Client) GetProject(key string) (*Project, error) {
+	res, err := c.GetV2(context.Background(), "/project/"+key+"?expand=lead,description", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Project
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetIssueTypes fetches issue types available in a project from the project metadata.
+func (c *Client) GetIssueTypes(project string) ([]*IssueType, error) {
+	p, err := c.GetProject(project)
+	if err != nil {
+		return nil, err
+	}
+	return p.IssueTypes, nil
+}
+
+// ProjectList holds response from /project/search endpoint.
+type ProjectList struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	IsLast     bool       `json:"isLast"`
+	Projects   []*Project `json:"values"`
+}
+
+// GetProjects fetches a page of projects matching the query using GET /project/search endpoint.
+// The query is matched against project key and name, an empty query returns all projects.
+// The endpoint is only available in Jira cloud.
+func (c *Client) GetProjects(startAt, maxResults int, query string) (*ProjectList, error) {
+	params := url.Values{}
+	params.Set("expand", "lead")
+	params.Set("startAt", strconv.Itoa(startAt))
+	params.Set("maxResults", strconv.Itoa(maxResults))
+	if query != "" {
+		params.Set("query", query)
+	}
+
+	res, err := c.Get(context.Background(), "/project/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectList
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
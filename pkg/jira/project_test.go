@@ -71,3 +71,138 @@ func TestProjects(t *testing.T) {
 	_, err = client.Project()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetProjects(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/project/search", r.URL.Path)
+
+		qs := r.URL.Query()
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, url.Values{
+				"expand":     []string{"lead"},
+				"startAt":    []string{"0"},
+				"maxResults": []string{"2"},
+				"query":      []string{"project"},
+			}, qs)
+
+			resp, err := os.ReadFile("./testdata/project-search.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetProjects(0, 2, "project")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, actual.Total)
+	assert.False(t, actual.IsLast)
+	assert.Len(t, actual.Projects, 2)
+	assert.Equal(t, "PRJ1", actual.Projects[0].Key)
+	assert.Equal(t, "Person A", actual.Projects[0].Lead.Name)
+	assert.Equal(t, ProjectTypeClassic, actual.Projects[0].Type)
+	assert.Equal(t, "software", actual.Projects[0].ProjectTypeKey)
+	assert.Equal(t, "PRJ2", actual.Projects[1].Key)
+	assert.Equal(t, "business", actual.Projects[1].ProjectTypeKey)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetProjects(0, 2, "project")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetProject(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/PRJ", r.URL.Path)
+		assert.Equal(t, "lead,description", r.URL.Query().Get("expand"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			resp, err := os.ReadFile("./testdata/project.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetProject("PRJ")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "10000", actual.ID)
+	assert.Equal(t, "PRJ", actual.Key)
+	assert.Equal(t, "Main project", actual.Description)
+	assert.Equal(t, "Person A", actual.Lead.Name)
+	assert.Equal(t, "software", actual.ProjectTypeKey)
+	assert.Equal(t, []*IssueType{
+		{ID: "10001", Name: "Story"},
+		{ID: "10002", Name: "Sub-task", Subtask: true},
+	}, actual.IssueTypes)
+	assert.Len(t, actual.Components, 1)
+	assert.Equal(t, "Backend", actual.Components[0].Name)
+	assert.Equal(t, []*ProjectVersion{
+		{ID: "10200", Name: "v1.0", Released: true, ReleaseDate: "2024-01-31", ProjectID: 10000},
+	}, actual.Versions)
+	assert.Equal(t, map[string]string{
+		"Administrators": "https://test.atlassian.net/rest/api/2/project/10000/role/10002",
+		"Developers":     "https://test.atlassian.net/rest/api/2/project/10000/role/10001",
+	}, actual.Roles)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetProject("PRJ")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetIssueTypes(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/PRJ", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			resp, err := os.ReadFile("./testdata/project.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueTypes("PRJ")
+	assert.NoError(t, err)
+
+	expected := []*IssueType{
+		{ID: "10001", Name: "Story"},
+		{ID: "10002", Name: "Sub-task", Subtask: true},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueTypes("PRJ")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
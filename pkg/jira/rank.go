@@ -0,0 +1,466 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// rankBatchSize is the maximum number of issues the rank endpoint accepts in a single request.
+const rankBatchSize = 50
+
+// RankOptions holds options for RankIssues. Either Before or After must be set.
+type RankOptions struct {
+	// Before is the key of the issue to rank the issues before.
+	Before string
+	// After is the key of the issue to rank the issues after.
+	After string
+	// CustomFieldID is the id of the rank field to use, default rank field is used if not set.
+	CustomFieldID int
+	// BoardID is the id of the board to rank the issues in. The rank field the board orders
+	// issues by is used unless CustomFieldID is set, as it may differ from the global rank
+	// field, eg: on team-managed boards.
+	BoardID int
+	// SkipRanked reads the current order first and skips issues that are already
+	// ranked in the desired position relative to the reference issue. This makes it
+	// safe to re-run a rank operation that partially failed.
+	SkipRanked bool
+}
+
+// RankResult holds the result of RankIssues.
+type RankResult struct {
+	// Ranked holds keys of the issues that were ranked successfully.
+	Ranked []string
+	// Skipped holds keys of the issues that were already in the desired position.
+	Skipped []string
+	// Failed holds error messages of the issues that couldn't be ranked keyed by issue key.
+	Failed map[string]string
+}
+
+// FailedKeys returns keys of the issues that couldn't be ranked in the order they were given.
+func (r *RankResult) FailedKeys(keys []string) []string {
+	out := make([]string, 0, len(r.Failed))
+	for _, k := range keys {
+		if _, ok := r.Failed[k]; ok {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+type rankRequest struct {
+	Issues            []string `json:"issues"`
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty"`
+	RankCustomFieldID int      `json:"rankCustomFieldId,omitempty"`
+}
+
+type rankResponse struct {
+	Entries []struct {
+		IssueKey string   `json:"issueKey"`
+		Status   int      `json:"status"`
+		Errors   []string `json:"errors"`
+	} `json:"entries"`
+}
+
+// RankPosition translates a 1-based position in the ordered list of issues to the
+// reference issue to rank the given keys before or after. The keys themselves are
+// not counted when determining the position so that moving an issue within the same
+// list works as expected. Position right after the last issue is also allowed.
+func RankPosition(order, keys []string, position int) (RankOptions, error) {
+	moving := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		moving[k] = struct{}{}
+	}
+
+	rest := make([]string, 0, len(order))
+	for _, k := range order {
+		if _, ok := moving[k]; !ok {
+			rest = append(rest, k)
+		}
+	}
+
+	if len(rest) == 0 {
+		return RankOptions{}, fmt.Errorf("no other issues to rank against")
+	}
+	if position < 1 || position > len(rest)+1 {
+		return RankOptions{}, fmt.Errorf("position %d is out of range, valid range is 1-%d", position, len(rest)+1)
+	}
+
+	if position > len(rest) {
+		return RankOptions{After: rest[len(rest)-1]}, nil
+	}
+	return RankOptions{Before: rest[position-1]}, nil
+}
+
+// RankIssues ranks issues before or after the given issue using PUT /issue/rank endpoint.
+// The issues keep the given order relative to each other. Issues are sent in batches
+// of 50, each subsequent batch is ranked after the last issue of the previous batch.
+//
+// Partial failures, ie: 207 responses, don't return an error. Keys of the issues that
+// couldn't be ranked are available in the result so that only those can be retried.
+// The result is returned along with the error if a request fails altogether.
+func (c *Client) RankIssues(keys []string, opts RankOptions) (*RankResult, error) {
+	return c.RankIssuesCtx(context.Background(), keys, opts)
+}
+
+// RankIssuesCtx is the same as RankIssues but uses the given context for the requests.
+// Progress is reported after each batch, see WithProgress.
+func (c *Client) RankIssuesCtx(ctx context.Context, keys []string, opts RankOptions) (*RankResult, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("issue keys are required")
+	}
+	if (opts.Before == "") == (opts.After == "") {
+		return nil, fmt.Errorf("exactly one of before or after issue is required")
+	}
+	if err := c.checkAgileEndpoint(); err != nil {
+		return nil, err
+	}
+
+	if opts.BoardID > 0 && opts.CustomFieldID == 0 {
+		cfg, err := c.GetBoardConfiguration(opts.BoardID)
+		if err != nil {
+			return nil, err
+		}
+		opts.CustomFieldID = cfg.Ranking.RankCustomFieldID
+	}
+
+	result := RankResult{Failed: make(map[string]string)}
+
+	if opts.SkipRanked {
+		var err error
+
+		keys, result.Skipped, opts, err = c.skipRanked(ctx, keys, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return &result, nil
+		}
+	}
+
+	var dryRun bool
+	for i := 0; i < len(keys); i += rankBatchSize {
+		batch := keys[i:min(i+rankBatchSize, len(keys))]
+
+		req := rankRequest{
+			Issues:            batch,
+			RankBeforeIssue:   opts.Before,
+			RankAfterIssue:    opts.After,
+			RankCustomFieldID: opts.CustomFieldID,
+		}
+		if i > 0 {
+			req.RankBeforeIssue, req.RankAfterIssue = "", keys[i-1]
+		}
+
+		failed, err := rank(ctx, c, &req)
+		if errors.Is(err, ErrDryRun) {
+			// Keep going so that the requests of all batches are printed.
+			dryRun = true
+			continue
+		}
+		if err != nil {
+			return &result, err
+		}
+		for _, k := range batch {
+			if msg, ok := failed[k]; ok {
+				result.Failed[k] = msg
+			} else {
+				result.Ranked = append(result.Ranked, k)
+			}
+		}
+		reportProgress(ctx, i+len(batch), len(keys))
+	}
+	if dryRun {
+		return &result, ErrDryRun
+	}
+
+	return &result, nil
+}
+
+// rank sends a single rank request and returns error messages of the issues that
+// couldn't be ranked keyed by issue key.
+func rank(ctx context.Context, r Requester, req *rankRequest) (map[string]string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.PutV1(ctx, "/issue/rank", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil, nil
+	case http.StatusMultiStatus:
+	default:
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out rankResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	failed := make(map[string]string)
+	for _, e := range out.Entries {
+		if e.Status >= http.StatusOK && e.Status < http.StatusMultipleChoices {
+			continue
+		}
+		msg := strings.Join(e.Errors, ", ")
+		if msg == "" {
+			msg = http.StatusText(e.Status)
+		}
+		failed[e.IssueKey] = msg
+	}
+	return failed, nil
+}
+
+// skipRanked removes issues that are already ranked in the desired position relative
+// to the reference issue. For before, it is the longest run of trailing keys directly
+// preceding the reference issue, and for after, the longest run of leading keys directly
+// following it. The reference is moved to the edge of the run so that the remaining
+// issues end up in the same place.
+//
+// Only the relative order of the given issues is considered, other issues ranked in
+// between them are not taken into account.
+func (c *Client) skipRanked(ctx context.Context, keys []string, opts RankOptions) ([]string, []string, RankOptions, error) {
+	ref := opts.Before + opts.After
+
+	order, err := c.rankOrder(ctx, append([]string{ref}, keys...))
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	pos := -1
+	for i, k := range order {
+		if k == ref {
+			pos = i
+			break
+		}
+	}
+	if pos == -1 {
+		return keys, nil, opts, nil
+	}
+
+	if opts.Before != "" {
+		n := 0
+		for n < len(keys) && pos-1-n >= 0 && order[pos-1-n] == keys[len(keys)-1-n] {
+			n++
+		}
+		if n > 0 {
+			opts.Before = keys[len(keys)-n]
+		}
+		return keys[:len(keys)-n], keys[len(keys)-n:], opts, nil
+	}
+
+	n := 0
+	for n < len(keys) && pos+1+n < len(order) && order[pos+1+n] == keys[n] {
+		n++
+	}
+	if n > 0 {
+		opts.After = keys[n-1]
+	}
+	return keys[n:], keys[:n], opts, nil
+}
+
+// rankOrder fetches the current rank order of the given issues. Cloud installations
+// use GET /search/jql endpoint as the /search endpoint is removed there.
+func (c *Client) rankOrder(ctx context.Context, keys []string) ([]string, error) {
+	quoted := make([]string, 0, len(keys))
+	for _, k := range keys {
+		quoted = append(quoted, fmt.Sprintf("%q", k))
+	}
+	jql := fmt.Sprintf("issuekey IN (%s) ORDER BY Rank ASC", strings.Join(quoted, ", "))
+
+	var (
+		issues []*Issue
+		err    error
+	)
+	if it, _ := c.InstallationType(); it == InstallationTypeLocal {
+		issues, err = c.SearchAllV2Ctx(ctx, jql, []string{"key"})
+	} else {
+		issues, err = c.SearchAllCtx(ctx, jql, []string{"key"})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		order = append(order, iss.Key)
+	}
+	return order, nil
+}
+
+// RankOperation is a single rank call needed to bring issues to the desired order.
+type RankOperation struct {
+	Keys   []string
+	Before string
+	After  string
+}
+
+// RankToOrder ranks the given issues so that they end up in the given order using
+// as few rank calls as possible. Issues that are already in the right order relative
+// to each other are left untouched, see RankOperations.
+//
+// The result holds the untouched issues as skipped. Operations continue even if some
+// issues fail to rank, so the call can simply be repeated to rank the failed ones.
+func (c *Client) RankToOrder(keys []string) (*RankResult, error) {
+	return c.RankToOrderCtx(context.Background(), keys)
+}
+
+// RankToOrderCtx is the same as RankToOrder but uses the given context for the rank
+// requests. Progress is reported in number of moved issues, see WithProgress.
+func (c *Client) RankToOrderCtx(ctx context.Context, keys []string) (*RankResult, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("issue keys are required")
+	}
+	if err := c.checkAgileEndpoint(); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		if _, ok := seen[k]; ok {
+			return nil, fmt.Errorf("issue %s is listed more than once", k)
+		}
+		seen[k] = struct{}{}
+	}
+
+	current, err := c.rankOrder(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) != len(keys) {
+		found := make(map[string]struct{}, len(current))
+		for _, k := range current {
+			found[k] = struct{}{}
+		}
+		for _, k := range keys {
+			if _, ok := found[k]; !ok {
+				return nil, fmt.Errorf("issue %s not found", k)
+			}
+		}
+	}
+
+	ops := RankOperations(current, keys)
+
+	moved := make(map[string]struct{}, len(keys))
+	for _, op := range ops {
+		for _, k := range op.Keys {
+			moved[k] = struct{}{}
+		}
+	}
+
+	result := RankResult{Failed: make(map[string]string)}
+	for _, k := range keys {
+		if _, ok := moved[k]; !ok {
+			result.Skipped = append(result.Skipped, k)
+		}
+	}
+
+	// Operations report their own progress per batch, which would jump back and forth.
+	opCtx := WithProgress(ctx, nil)
+
+	var (
+		done   int
+		dryRun bool
+	)
+	for _, op := range ops {
+		res, err := c.RankIssuesCtx(opCtx, op.Keys, RankOptions{Before: op.Before, After: op.After})
+		if res != nil {
+			result.Ranked = append(result.Ranked, res.Ranked...)
+			for k, msg := range res.Failed {
+				result.Failed[k] = msg
+			}
+		}
+		if errors.Is(err, ErrDryRun) {
+			dryRun = true
+			continue
+		}
+		if err != nil {
+			return &result, err
+		}
+		done += len(op.Keys)
+		reportProgress(ctx, done, len(moved))
+	}
+	if dryRun {
+		return &result, ErrDryRun
+	}
+
+	return &result, nil
+}
+
+// RankOperations diffs the current and the desired order of issues and returns rank
+// operations to get from one to the other. The longest run of issues that are already
+// in the right relative order stays in place and every other issue is moved right after
+// its predecessor in the desired order, consecutive issues are moved together.
+func RankOperations(current, desired []string) []RankOperation {
+	pos := make(map[string]int, len(current))
+	for i, k := range current {
+		pos[k] = i
+	}
+
+	// Longest increasing subsequence of current positions in the desired order.
+	var (
+		tails = make([]int, 0, len(desired))
+		prev  = make([]int, len(desired))
+	)
+	for i, k := range desired {
+		p := pos[k]
+		j := sort.Search(len(tails), func(j int) bool { return pos[desired[tails[j]]] >= p })
+		prev[i] = -1
+		if j > 0 {
+			prev[i] = tails[j-1]
+		}
+		if j == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[j] = i
+		}
+	}
+
+	keep := make([]bool, len(desired))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			keep[i] = true
+		}
+	}
+
+	var ops []RankOperation
+	for i := 0; i < len(desired); {
+		if keep[i] {
+			i++
+			continue
+		}
+		j := i
+		for j < len(desired) && !keep[j] {
+			j++
+		}
+
+		op := RankOperation{Keys: desired[i:j]}
+		if i > 0 {
+			op.After = desired[i-1]
+		} else {
+			op.Before = desired[j]
+		}
+		ops = append(ops, op)
+
+		i = j
+	}
+	return ops
+}
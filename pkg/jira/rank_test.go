@@ -0,0 +1,523 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankIssues(t *testing.T) {
+	var (
+		requests   []rankRequest
+		statusCode int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/issue/rank", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		var req rankRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		switch statusCode {
+		case 207:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(207)
+			_, _ = w.Write([]byte(`{"entries":[
+				{"issueId":10001,"issueKey":"TEST-1","status":200},
+				{"issueId":10002,"issueKey":"TEST-2","status":400,"errors":["Issue is not on the board"]}
+			]}`))
+		default:
+			w.WriteHeader(statusCode)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	statusCode = 204
+
+	result, err := client.RankIssues([]string{"TEST-1", "TEST-2"}, RankOptions{Before: "TEST-5"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-1", "TEST-2"}, result.Ranked)
+	assert.Empty(t, result.Failed)
+	assert.Equal(t, []rankRequest{{Issues: []string{"TEST-1", "TEST-2"}, RankBeforeIssue: "TEST-5"}}, requests)
+
+	statusCode = 207
+
+	result, err = client.RankIssues([]string{"TEST-1", "TEST-2"}, RankOptions{After: "TEST-5"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-1"}, result.Ranked)
+	assert.Equal(t, map[string]string{"TEST-2": "Issue is not on the board"}, result.Failed)
+	assert.Equal(t, []string{"TEST-2"}, result.FailedKeys([]string{"TEST-1", "TEST-2"}))
+
+	statusCode = 400
+
+	_, err = client.RankIssues([]string{"TEST-1"}, RankOptions{After: "TEST-5"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+
+	_, err = client.RankIssues([]string{"TEST-1"}, RankOptions{})
+	assert.Error(t, err)
+
+	_, err = client.RankIssues([]string{"TEST-1"}, RankOptions{Before: "TEST-2", After: "TEST-3"})
+	assert.Error(t, err)
+}
+
+func TestRankIssuesInBoard(t *testing.T) {
+	var requests []rankRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/7/configuration":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":7,"name":"Team board","ranking":{"rankCustomFieldId":10019}}`))
+		case "/rest/agile/1.0/issue/rank":
+			var req rankRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			requests = append(requests, req)
+			w.WriteHeader(204)
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err := client.RankIssues([]string{"TEST-1"}, RankOptions{Before: "TEST-5", BoardID: 7})
+	assert.NoError(t, err)
+
+	// Explicit rank field has precedence over the one of the board.
+	_, err = client.RankIssues([]string{"TEST-1"}, RankOptions{Before: "TEST-5", BoardID: 7, CustomFieldID: 10001})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []rankRequest{
+		{Issues: []string{"TEST-1"}, RankBeforeIssue: "TEST-5", RankCustomFieldID: 10019},
+		{Issues: []string{"TEST-1"}, RankBeforeIssue: "TEST-5", RankCustomFieldID: 10001},
+	}, requests)
+}
+
+func TestRankIssuesInBatches(t *testing.T) {
+	var requests []rankRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rankRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	keys := make([]string, 0, 120)
+	for i := 1; i <= 120; i++ {
+		keys = append(keys, fmt.Sprintf("TEST-%d", i))
+	}
+
+	var reported []int
+
+	ctx := WithProgress(context.Background(), func(done, total int) {
+		assert.Equal(t, 120, total)
+		reported = append(reported, done)
+	})
+
+	result, err := client.RankIssuesCtx(ctx, keys, RankOptions{Before: "TEST-500"})
+	assert.NoError(t, err)
+	assert.Equal(t, keys, result.Ranked)
+	assert.Equal(t, []int{50, 100, 120}, reported)
+
+	assert.Len(t, requests, 3)
+	assert.Equal(t, keys[:50], requests[0].Issues)
+	assert.Equal(t, "TEST-500", requests[0].RankBeforeIssue)
+	assert.Equal(t, keys[50:100], requests[1].Issues)
+	assert.Equal(t, "TEST-50", requests[1].RankAfterIssue)
+	assert.Empty(t, requests[1].RankBeforeIssue)
+	assert.Equal(t, keys[100:], requests[2].Issues)
+	assert.Equal(t, "TEST-100", requests[2].RankAfterIssue)
+}
+
+func TestRankIssuesInBatchesDryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(Config{Server: server.URL, DryRun: true}, WithTimeout(3*time.Second))
+	client.output = &out
+
+	keys := make([]string, 0, 120)
+	for i := 1; i <= 120; i++ {
+		keys = append(keys, fmt.Sprintf("TEST-%d", i))
+	}
+
+	_, err := client.RankIssuesCtx(context.Background(), keys, RankOptions{Before: "TEST-500"})
+	assert.ErrorIs(t, err, ErrDryRun)
+	assert.Equal(t, 3, strings.Count(out.String(), "PUT "+server.URL+"/rest/agile/1.0/issue/rank"))
+}
+
+// fakeRequester records PUT requests to the v1 api and responds with the given response.
+type fakeRequester struct {
+	Requester
+
+	paths  []string
+	bodies []string
+	res    *http.Response
+	err    error
+}
+
+func (f *fakeRequester) PutV1(_ context.Context, path string, body []byte, _ Header) (*http.Response, error) {
+	f.paths = append(f.paths, path)
+	f.bodies = append(f.bodies, string(body))
+	return f.res, f.err
+}
+
+func TestRankWithFakeRequester(t *testing.T) {
+	fake := fakeRequester{
+		res: &http.Response{
+			StatusCode: http.StatusMultiStatus,
+			Body: io.NopCloser(strings.NewReader(
+				`{"entries":[{"issueKey":"TEST-1","status":200},{"issueKey":"TEST-2","status":403,"errors":["No permission"]}]}`,
+			)),
+		},
+	}
+
+	failed, err := rank(context.Background(), &fake, &rankRequest{Issues: []string{"TEST-1", "TEST-2"}, RankAfterIssue: "TEST-5"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"TEST-2": "No permission"}, failed)
+	assert.Equal(t, []string{"/issue/rank"}, fake.paths)
+	assert.Equal(t, []string{`{"issues":["TEST-1","TEST-2"],"rankAfterIssue":"TEST-5"}`}, fake.bodies)
+
+	fake.res = &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}
+
+	failed, err = rank(context.Background(), &fake, &rankRequest{Issues: []string{"TEST-1"}, RankBeforeIssue: "TEST-5"})
+	assert.NoError(t, err)
+	assert.Nil(t, failed)
+
+	fake.res = nil
+
+	_, err = rank(context.Background(), &fake, &rankRequest{Issues: []string{"TEST-1"}, RankBeforeIssue: "TEST-5"})
+	assert.ErrorIs(t, err, ErrEmptyResponse)
+}
+
+func TestRankIssuesSkipRanked(t *testing.T) {
+	cases := []struct {
+		name     string
+		order    []string
+		keys     []string
+		opts     RankOptions
+		ranked   []rankRequest
+		skipped  []string
+		rankKeys []string
+	}{
+		{
+			name:    "it skips all issues already ranked before the reference",
+			order:   []string{"TEST-9", "TEST-1", "TEST-2", "TEST-5"},
+			keys:    []string{"TEST-1", "TEST-2"},
+			opts:    RankOptions{Before: "TEST-5"},
+			skipped: []string{"TEST-1", "TEST-2"},
+		},
+		{
+			name:     "it ranks remaining issues before the first ranked one",
+			order:    []string{"TEST-1", "TEST-2", "TEST-5", "TEST-3"},
+			keys:     []string{"TEST-3", "TEST-1", "TEST-2"},
+			opts:     RankOptions{Before: "TEST-5"},
+			ranked:   []rankRequest{{Issues: []string{"TEST-3"}, RankBeforeIssue: "TEST-1"}},
+			skipped:  []string{"TEST-1", "TEST-2"},
+			rankKeys: []string{"TEST-3"},
+		},
+		{
+			name:     "it ranks remaining issues after the last ranked one",
+			order:    []string{"TEST-5", "TEST-1", "TEST-3", "TEST-2"},
+			keys:     []string{"TEST-1", "TEST-2", "TEST-3"},
+			opts:     RankOptions{After: "TEST-5"},
+			ranked:   []rankRequest{{Issues: []string{"TEST-2", "TEST-3"}, RankAfterIssue: "TEST-1"}},
+			skipped:  []string{"TEST-1"},
+			rankKeys: []string{"TEST-2", "TEST-3"},
+		},
+		{
+			name:     "it ranks all issues if none is in position",
+			order:    []string{"TEST-2", "TEST-1", "TEST-5"},
+			keys:     []string{"TEST-1", "TEST-2"},
+			opts:     RankOptions{Before: "TEST-5"},
+			ranked:   []rankRequest{{Issues: []string{"TEST-1", "TEST-2"}, RankBeforeIssue: "TEST-5"}},
+			rankKeys: []string{"TEST-1", "TEST-2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []rankRequest
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/rest/api/2/serverInfo" {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(200)
+					_, _ = w.Write([]byte(`{"deploymentType":"DataCenter"}`))
+					return
+				}
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+
+					issues := make([]map[string]string, 0, len(tc.order))
+					for _, k := range tc.order {
+						issues = append(issues, map[string]string{"key": k})
+					}
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(200)
+					_ = json.NewEncoder(w).Encode(map[string]any{"total": len(issues), "issues": issues})
+					return
+				}
+
+				var req rankRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				requests = append(requests, req)
+
+				w.WriteHeader(204)
+			}))
+			defer server.Close()
+
+			client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+			opts := tc.opts
+			opts.SkipRanked = true
+
+			result, err := client.RankIssues(tc.keys, opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ranked, requests)
+			assert.ElementsMatch(t, tc.skipped, result.Skipped)
+			assert.Equal(t, tc.rankKeys, result.Ranked)
+		})
+	}
+}
+
+func TestRankIssuesWithoutAgileEndpoint(t *testing.T) {
+	client := NewClient(Config{}, WithTimeout(3*time.Second))
+
+	_, err := client.RankIssues([]string{"TEST-1"}, RankOptions{Before: "TEST-2"})
+	assert.ErrorIs(t, err, ErrNoAgileEndpoint)
+
+	_, err = client.RankToOrder([]string{"TEST-1", "TEST-2"})
+	assert.ErrorIs(t, err, ErrNoAgileEndpoint)
+
+	client = NewClient(Config{Server: "jira.example.com"}, WithTimeout(3*time.Second))
+
+	_, err = client.RankIssues([]string{"TEST-1"}, RankOptions{After: "TEST-2"})
+	assert.ErrorIs(t, err, ErrNoAgileEndpoint)
+	assert.EqualError(t, err, `jira: agile endpoint not configured: invalid URL "jira.example.com"`)
+}
+
+func TestRankPosition(t *testing.T) {
+	order := []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4"}
+
+	cases := []struct {
+		name     string
+		keys     []string
+		position int
+		expected RankOptions
+		err      string
+	}{
+		{
+			name:     "first",
+			keys:     []string{"TEST-4"},
+			position: 1,
+			expected: RankOptions{Before: "TEST-1"},
+		},
+		{
+			name:     "middle ignores moving issues",
+			keys:     []string{"TEST-1"},
+			position: 2,
+			expected: RankOptions{Before: "TEST-3"},
+		},
+		{
+			name:     "after last",
+			keys:     []string{"TEST-1", "TEST-5"},
+			position: 4,
+			expected: RankOptions{After: "TEST-4"},
+		},
+		{
+			name:     "out of range",
+			keys:     []string{"TEST-1"},
+			position: 5,
+			err:      "position 5 is out of range, valid range is 1-4",
+		},
+		{
+			name:     "zero",
+			keys:     []string{"TEST-1"},
+			position: 0,
+			err:      "position 0 is out of range, valid range is 1-4",
+		},
+		{
+			name:     "nothing to rank against",
+			keys:     order,
+			position: 1,
+			err:      "no other issues to rank against",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := RankPosition(order, tc.keys, tc.position)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestRankOperations(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  []string
+		desired  []string
+		expected []RankOperation
+	}{
+		{
+			name:    "already in order",
+			current: []string{"A", "B", "C"},
+			desired: []string{"A", "B", "C"},
+		},
+		{
+			name:     "moves a single issue",
+			current:  []string{"A", "B", "C", "D"},
+			desired:  []string{"A", "C", "D", "B"},
+			expected: []RankOperation{{Keys: []string{"B"}, After: "D"}},
+		},
+		{
+			name:     "moves leading issues before the first kept one",
+			current:  []string{"A", "B", "C", "D"},
+			desired:  []string{"D", "C", "A", "B"},
+			expected: []RankOperation{{Keys: []string{"D", "C"}, Before: "A"}},
+		},
+		{
+			name:    "reversed order",
+			current: []string{"A", "B", "C"},
+			desired: []string{"C", "B", "A"},
+			expected: []RankOperation{
+				{Keys: []string{"C", "B"}, Before: "A"},
+			},
+		},
+		{
+			name:    "moves multiple runs",
+			current: []string{"A", "B", "C", "D", "E", "F"},
+			desired: []string{"B", "A", "C", "F", "D", "E"},
+			expected: []RankOperation{
+				{Keys: []string{"B"}, Before: "A"},
+				{Keys: []string{"F"}, After: "C"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, RankOperations(tc.current, tc.desired))
+		})
+	}
+}
+
+func TestRankToOrder(t *testing.T) {
+	var requests []rankRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/2/serverInfo" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"deploymentType":"DataCenter"}`))
+			return
+		}
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"total":4,"issues":[{"key":"TEST-1"},{"key":"TEST-2"},{"key":"TEST-3"},{"key":"TEST-4"}]}`))
+			return
+		}
+
+		var req rankRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	result, err := client.RankToOrder([]string{"TEST-4", "TEST-1", "TEST-3", "TEST-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []rankRequest{
+		{Issues: []string{"TEST-4"}, RankBeforeIssue: "TEST-1"},
+		{Issues: []string{"TEST-3"}, RankAfterIssue: "TEST-1"},
+	}, requests)
+	assert.Equal(t, []string{"TEST-4", "TEST-3"}, result.Ranked)
+	assert.Equal(t, []string{"TEST-1", "TEST-2"}, result.Skipped)
+
+	_, err = client.RankToOrder([]string{"TEST-1", "TEST-1"})
+	assert.EqualError(t, err, "issue TEST-1 is listed more than once")
+
+	_, err = client.RankToOrder([]string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5"})
+	assert.EqualError(t, err, "issue TEST-5 not found")
+}
+
+func TestRankToOrderCloud(t *testing.T) {
+	var (
+		tokens   []string
+		requests []rankRequest
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/rest/api/2/serverInfo":
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"deploymentType":"Cloud"}`))
+		case r.Method == http.MethodGet:
+			assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+			assert.Equal(t, "key", r.URL.Query().Get("fields"))
+
+			token := r.URL.Query().Get("nextPageToken")
+			tokens = append(tokens, token)
+
+			w.WriteHeader(200)
+			if token == "" {
+				_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-1"},{"key":"TEST-2"}],"nextPageToken":"next"}`))
+			} else {
+				_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-3"}],"isLast":true}`))
+			}
+		default:
+			var req rankRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			requests = append(requests, req)
+
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	result, err := client.RankToOrder([]string{"TEST-3", "TEST-1", "TEST-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "next"}, tokens)
+	assert.Equal(t, []rankRequest{{Issues: []string{"TEST-3"}, RankBeforeIssue: "TEST-1"}}, requests)
+	assert.Equal(t, []string{"TEST-3"}, result.Ranked)
+}
@@ -8,7 +8,14 @@ import (
 )
 
 // Release fetches response from /project/{projectIdOrKey}/version endpoint.
+//
+// Deprecated: use GetVersions instead.
 func (c *Client) Release(project string) ([]*ProjectVersion, error) {
+	return c.GetVersions(project)
+}
+
+// GetVersions fetches versions of a project using GET /project/{projectIdOrKey}/versions endpoint.
+func (c *Client) GetVersions(project string) ([]*ProjectVersion, error) {
 	path := fmt.Sprintf("/project/%s/versions", project)
 	res, err := c.Get(context.Background(), path, nil)
 	if err != nil {
@@ -29,3 +36,81 @@ func (c *Client) Release(project string) ([]*ProjectVersion, error) {
 
 	return out, err
 }
+
+type versionRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Released    *bool  `json:"released,omitempty"`
+}
+
+// CreateVersion creates a new version in a project using POST /version endpoint.
+// The release date is optional and should be in YYYY-MM-DD format.
+func (c *Client) CreateVersion(project, name, description, releaseDate string) (*ProjectVersion, error) {
+	body, err := json.Marshal(&versionRequest{
+		Name:        name,
+		Description: description,
+		Project:     project,
+		ReleaseDate: releaseDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Post(context.Background(), "/version", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectVersion
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// ReleaseVersion marks a version as released or unreleased using PUT /version/{id} endpoint.
+// The release date is only sent if it is not empty.
+func (c *Client) ReleaseVersion(versionID string, released bool, releaseDate string) (*ProjectVersion, error) {
+	body, err := json.Marshal(&versionRequest{
+		ReleaseDate: releaseDate,
+		Released:    &released,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Put(context.Background(), "/version/"+versionID, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectVersion
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -68,3 +70,82 @@ func TestReleases(t *testing.T) {
 	_, err = client.Release("1000")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateVersion(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/version", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"name":"v1.0.0","description":"First release","project":"TEST","releaseDate":"2024-01-31"}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id":"10010","name":"v1.0.0","description":"First release","released":false,"releaseDate":"2024-01-31","projectId":1000}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.CreateVersion("TEST", "v1.0.0", "First release", "2024-01-31")
+	assert.NoError(t, err)
+
+	expected := &ProjectVersion{
+		ID:          "10010",
+		Name:        "v1.0.0",
+		Description: "First release",
+		ReleaseDate: "2024-01-31",
+		ProjectID:   1000,
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateVersion("TEST", "v1.0.0", "First release", "2024-01-31")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestReleaseVersion(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/version/10010", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"released":true,"releaseDate":"2024-02-01"}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":"10010","name":"v1.0.0","released":true,"releaseDate":"2024-02-01","projectId":1000}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ReleaseVersion("10010", true, "2024-02-01")
+	assert.NoError(t, err)
+	assert.True(t, actual.Released)
+	assert.Equal(t, "2024-02-01", actual.ReleaseDate)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ReleaseVersion("10010", true, "2024-02-01")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
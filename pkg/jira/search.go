@@ -4,41 +4,175 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
-// SearchResult struct holds response from /search endpoint.
+const (
+	// defaultMaxResults is the page size used if Config.DefaultMaxResults is not set.
+	defaultMaxResults = 100
+	// maxResultsCap is the upper limit of Config.DefaultMaxResults. Servers cap
+	// the page size anyway, larger values only result in bigger responses.
+	maxResultsCap = 1000
+)
+
+// SearchResult struct holds response from /search and /search/jql endpoints.
+//
+// The /search/jql endpoint uses cursor based pagination, so StartAt, MaxResults and
+// Total are not set in its response. Use NextPageToken to fetch the next page instead.
 type SearchResult struct {
-	StartAt    int      `json:"startAt"`
-	MaxResults int      `json:"maxResults"`
-	Total      int      `json:"total"`
-	Issues     []*Issue `json:"issues"`
+	StartAt       int      `json:"startAt"`
+	MaxResults    int      `json:"maxResults"`
+	Total         int      `json:"total"`
+	Issues        []*Issue `json:"issues"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+	IsLast        bool     `json:"isLast"`
 }
 
 // Search searches for issues using v3 version of the Jira GET /search endpoint.
 func (c *Client) Search(jql string, from, limit uint) (*SearchResult, error) {
-	return c.search(jql, from, limit, apiVersion3)
+	return c.search(context.Background(), jql, from, limit, nil, apiVersion3)
+}
+
+// SearchCtx is the same as Search but uses the given context for the request.
+func (c *Client) SearchCtx(ctx context.Context, jql string, from, limit uint) (*SearchResult, error) {
+	return c.search(ctx, jql, from, limit, nil, apiVersion3)
 }
 
 // SearchV2 searches an issues using v2 version of the Jira GET /search endpoint.
 func (c *Client) SearchV2(jql string, from, limit uint) (*SearchResult, error) {
-	return c.search(jql, from, limit, apiVersion2)
+	return c.search(context.Background(), jql, from, limit, nil, apiVersion2)
+}
+
+// SearchV2Ctx is the same as SearchV2 but uses the given context for the request.
+func (c *Client) SearchV2Ctx(ctx context.Context, jql string, from, limit uint) (*SearchResult, error) {
+	return c.search(ctx, jql, from, limit, nil, apiVersion2)
+}
+
+// SearchWithFields is the same as Search but only fetches the given fields of the issues.
+// Use it to reduce the size of the response when only a few fields are needed.
+func (c *Client) SearchWithFields(jql string, from, limit uint, fields []string) (*SearchResult, error) {
+	return c.search(context.Background(), jql, from, limit, fields, apiVersion3)
+}
+
+// SearchV2WithFields is the same as SearchV2 but only fetches the given fields of the issues.
+func (c *Client) SearchV2WithFields(jql string, from, limit uint, fields []string) (*SearchResult, error) {
+	return c.search(context.Background(), jql, from, limit, fields, apiVersion2)
+}
+
+// SearchRaw searches for issues same as SearchJQL but returns the raw API response body string.
+// Use it to get fields that are not decoded in SearchResult, eg: to pipe the output to jq.
+//
+// GET /search/jql endpoint doesn't support offsets, so the pages before startAt are read
+// with issue ids only to get the token of the page to return.
+func (c *Client) SearchRaw(jql string, startAt, maxResults int) (string, error) {
+	return c.searchRaw(context.Background(), jql, startAt, maxResults, apiVersion3)
+}
+
+// SearchRawCtx is the same as SearchRaw but uses the given context for the request.
+func (c *Client) SearchRawCtx(ctx context.Context, jql string, startAt, maxResults int) (string, error) {
+	return c.searchRaw(ctx, jql, startAt, maxResults, apiVersion3)
+}
+
+// SearchV2Raw searches for issues same as SearchV2 but returns the raw API response body string.
+func (c *Client) SearchV2Raw(jql string, startAt, maxResults int) (string, error) {
+	return c.searchRaw(context.Background(), jql, startAt, maxResults, apiVersion2)
 }
 
-func (c *Client) search(jql string, from, limit uint, ver string) (*SearchResult, error) {
+func (c *Client) searchRaw(ctx context.Context, jql string, startAt, maxResults int, ver string) (string, error) {
 	var (
 		res *http.Response
 		err error
 	)
 
+	ver = c.resolveVersion(ver)
+
+	switch ver {
+	case apiVersion2:
+		path := fmt.Sprintf("/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql), startAt, maxResults)
+		res, err = c.GetV2(ctx, path, nil)
+	default:
+		token, ok, terr := c.searchJQLToken(ctx, jql, startAt)
+		if terr != nil {
+			return "", terr
+		}
+		if !ok {
+			return `{"issues":[],"isLast":true}`, nil
+		}
+		if maxResults <= 0 {
+			maxResults = c.defaultMaxResults()
+		}
+
+		path := fmt.Sprintf(
+			"/search/jql?jql=%s&maxResults=%d&fields=%s",
+			url.QueryEscape(jql), maxResults, url.QueryEscape("*navigable"),
+		)
+		if token != "" {
+			path += fmt.Sprintf("&nextPageToken=%s", url.QueryEscape(token))
+		}
+		res, err = c.Get(ctx, path, nil)
+	}
+
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return "", formatUnexpectedResponse(res)
+	}
+
+	var b strings.Builder
+	_, err = io.Copy(&b, res.Body)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// searchJQLToken returns the token of the GET /search/jql page starting at the offset,
+// the token is empty for the first page. False is returned if there are no issues from
+// the offset onwards.
+func (c *Client) searchJQLToken(ctx context.Context, jql string, offset int) (string, bool, error) {
+	var token string
+	for skipped := 0; skipped < offset; {
+		res, err := c.SearchJQLCtx(ctx, jql, token, min(offset-skipped, c.defaultMaxResults()), []string{"id"})
+		if err != nil {
+			return "", false, err
+		}
+		skipped += len(res.Issues)
+
+		token = res.NextPageToken
+		if res.IsLast || token == "" || len(res.Issues) == 0 {
+			return "", false, nil
+		}
+	}
+	return token, true, nil
+}
+
+func (c *Client) search(ctx context.Context, jql string, from, limit uint, fields []string, ver string) (*SearchResult, error) {
+	var (
+		res *http.Response
+		err error
+	)
+
+	ver = c.resolveVersion(ver)
+
 	path := fmt.Sprintf("/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql), from, limit)
+	if len(fields) > 0 {
+		path += fmt.Sprintf("&fields=%s", url.QueryEscape(strings.Join(fields, ",")))
+	}
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -59,3 +193,192 @@ func (c *Client) search(jql string, from, limit uint, ver string) (*SearchResult
 
 	return &out, err
 }
+
+// SearchJQL searches for issues using GET /search/jql endpoint. The endpoint replaces
+// /search in Jira cloud and uses cursor based pagination, pass an empty token to fetch
+// the first page and NextPageToken of the result to fetch the subsequent ones.
+//
+// Unlike /search, the endpoint only returns issue ids by default, so all navigable
+// fields are fetched if fields is empty.
+func (c *Client) SearchJQL(jql string, token string, maxResults int, fields []string) (*SearchResult, error) {
+	return c.SearchJQLCtx(context.Background(), jql, token, maxResults, fields)
+}
+
+// SearchJQLCtx is the same as SearchJQL but uses the given context for the request.
+func (c *Client) SearchJQLCtx(ctx context.Context, jql string, token string, maxResults int, fields []string) (*SearchResult, error) {
+	if len(fields) == 0 {
+		fields = []string{"*navigable"}
+	}
+	if maxResults <= 0 {
+		maxResults = c.defaultMaxResults()
+	}
+
+	path := fmt.Sprintf(
+		"/search/jql?jql=%s&maxResults=%d&fields=%s",
+		url.QueryEscape(jql), maxResults, url.QueryEscape(strings.Join(fields, ",")),
+	)
+	if token != "" {
+		path += fmt.Sprintf("&nextPageToken=%s", url.QueryEscape(token))
+	}
+
+	res, err := c.Get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SearchResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// SearchJQLFrom is the same as SearchWithFields but uses GET /search/jql endpoint. Pages
+// are followed until the issues from the given offset are read as the endpoint doesn't
+// support offset based pagination.
+//
+// The endpoint doesn't return the total number of issues either. If there are more issues
+// than read, the total is estimated using POST /search/approximate-count endpoint.
+func (c *Client) SearchJQLFrom(jql string, from, limit uint, fields []string) (*SearchResult, error) {
+	var (
+		issues []*Issue
+		token  string
+		isLast bool
+		want   = int(from + limit)
+	)
+
+	for len(issues) < want {
+		res, err := c.SearchJQL(jql, token, min(want-len(issues), c.defaultMaxResults()), fields)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, res.Issues...)
+
+		token = res.NextPageToken
+		if res.IsLast || token == "" || len(res.Issues) == 0 {
+			isLast = true
+			break
+		}
+	}
+
+	out := SearchResult{
+		StartAt:    int(from),
+		MaxResults: int(limit),
+		Total:      len(issues),
+		IsLast:     isLast,
+	}
+	if int(from) < len(issues) {
+		out.Issues = issues[from:min(len(issues), want)]
+	}
+	if !isLast {
+		out.NextPageToken = token
+		if count, err := c.ApproximateCount(jql); err == nil && count > out.Total {
+			out.Total = count
+		}
+	}
+
+	return &out, nil
+}
+
+// SearchAll fetches every issue matching the JQL using GET /search/jql endpoint,
+// following pages of Config.DefaultMaxResults issues until all are read.
+func (c *Client) SearchAll(jql string, fields []string) ([]*Issue, error) {
+	return c.SearchAllCtx(context.Background(), jql, fields)
+}
+
+// SearchAllCtx is the same as SearchAll but uses the given context for the requests.
+func (c *Client) SearchAllCtx(ctx context.Context, jql string, fields []string) ([]*Issue, error) {
+	var (
+		issues []*Issue
+		token  string
+	)
+
+	for {
+		res, err := c.SearchJQLCtx(ctx, jql, token, c.defaultMaxResults(), fields)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, res.Issues...)
+
+		token = res.NextPageToken
+		if res.IsLast || token == "" || len(res.Issues) == 0 {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
+// SearchAllV2 is the same as SearchAll but uses v2 version of the GET /search endpoint
+// with offset based pagination. Use it for on-premise installations.
+func (c *Client) SearchAllV2(jql string, fields []string) ([]*Issue, error) {
+	return c.SearchAllV2Ctx(context.Background(), jql, fields)
+}
+
+// SearchAllV2Ctx is the same as SearchAllV2 but uses the given context for the requests.
+func (c *Client) SearchAllV2Ctx(ctx context.Context, jql string, fields []string) ([]*Issue, error) {
+	var issues []*Issue
+
+	for {
+		res, err := c.search(ctx, jql, uint(len(issues)), uint(c.defaultMaxResults()), fields, apiVersion2)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, res.Issues...)
+
+		if len(res.Issues) == 0 || len(issues) >= res.Total {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
+// defaultMaxResults returns the configured page size within the allowed bounds.
+func (c *Client) defaultMaxResults() int {
+	if c.pageSize <= 0 {
+		return defaultMaxResults
+	}
+	return min(c.pageSize, maxResultsCap)
+}
+
+// ApproximateCount returns an estimated number of issues matching the JQL using
+// POST /search/approximate-count endpoint. The endpoint is only available in Jira cloud.
+func (c *Client) ApproximateCount(jql string) (int, error) {
+	body, err := json.Marshal(map[string]string{"jql": jql})
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.Post(readOnly(context.Background()), "/search/approximate-count", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Count int `json:"count"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Count, err
+}
@@ -72,9 +72,7 @@ func TestSearch(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 1},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
+					Status:  IssueStatus{Name: "To Do"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -95,9 +93,7 @@ func TestSearch(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 12},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "In Progress"},
+					Status:  IssueStatus{Name: "In Progress"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -124,9 +120,7 @@ func TestSearch(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: false, WatchCount: 3},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "Done"},
+					Status:  IssueStatus{Name: "Done"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -141,3 +135,302 @@ func TestSearch(t *testing.T) {
 	_, err = client.SearchV2("project=TEST", 0, 100)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSearchRaw(t *testing.T) {
+	var (
+		apiVersion2          bool
+		unexpectedStatusCode bool
+		tokens               []string
+	)
+
+	resp, err := os.ReadFile("./testdata/search.json")
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apiVersion2 {
+			assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+			assert.Equal(t, url.Values{
+				"jql":        []string{"project=TEST"},
+				"startAt":    []string{"10"},
+				"maxResults": []string{"20"},
+			}, r.URL.Query())
+		} else {
+			assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+			assert.Equal(t, "project=TEST", r.URL.Query().Get("jql"))
+
+			token := r.URL.Query().Get("nextPageToken")
+			tokens = append(tokens, token)
+
+			// Skipped pages only request issue ids.
+			if token == "" {
+				assert.Equal(t, "id", r.URL.Query().Get("fields"))
+				assert.Equal(t, "10", r.URL.Query().Get("maxResults"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(200)
+				_, _ = w.Write([]byte(`{"issues":[{"id":"1"},{"id":"2"},{"id":"3"},{"id":"4"},{"id":"5"},{"id":"6"},{"id":"7"},{"id":"8"},{"id":"9"},{"id":"10"}],"nextPageToken":"p2"}`))
+				return
+			}
+			assert.Equal(t, "*navigable", r.URL.Query().Get("fields"))
+			assert.Equal(t, "20", r.URL.Query().Get("maxResults"))
+		}
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchRaw("project=TEST", 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, string(resp), actual)
+	assert.Equal(t, []string{"", "p2"}, tokens)
+
+	apiVersion2 = true
+
+	actual, err = client.SearchV2Raw("project=TEST", 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, string(resp), actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SearchV2Raw("project=TEST", 10, 20)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestSearchRawPastLastPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.Equal(t, "id", r.URL.Query().Get("fields"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"issues":[{"id":"1"}],"isLast":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchRaw("project=TEST", 5, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"issues":[],"isLast":true}`, actual)
+}
+
+func TestSearchWithFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, url.Values{
+			"jql":        []string{"project=TEST"},
+			"startAt":    []string{"0"},
+			"maxResults": []string{"10"},
+			"fields":     []string{"summary,status"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"total":1,"issues":[{"key":"TEST-1","fields":{"summary":"Test","status":{"name":"Done"}}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchWithFields("project=TEST", 0, 10, []string{"summary", "status"})
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-1", actual.Issues[0].Key)
+	assert.Equal(t, "Done", actual.Issues[0].Fields.Status.Name)
+
+	_, err = client.SearchV2WithFields("project=TEST", 0, 10, []string{"summary", "status"})
+	assert.NoError(t, err)
+}
+
+func TestSearchJQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"jql":           []string{"project=TEST"},
+			"maxResults":    []string{"2"},
+			"fields":        []string{"*navigable"},
+			"nextPageToken": []string{"abc"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-3"},{"key":"TEST-4"}],"nextPageToken":"def","isLast":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchJQL("project=TEST", "abc", 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &SearchResult{
+		Issues:        []*Issue{{Key: "TEST-3"}, {Key: "TEST-4"}},
+		NextPageToken: "def",
+	}, actual)
+}
+
+func TestSearchJQLFrom(t *testing.T) {
+	var (
+		tokens []string
+		limits []string
+		counts int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/rest/api/3/search/approximate-count" {
+			assert.Equal(t, http.MethodPost, r.Method)
+			counts++
+
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"count":10}`))
+			return
+		}
+
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.Equal(t, "summary", r.URL.Query().Get("fields"))
+
+		token := r.URL.Query().Get("nextPageToken")
+		tokens = append(tokens, token)
+		limits = append(limits, r.URL.Query().Get("maxResults"))
+
+		w.WriteHeader(200)
+		switch token {
+		case "":
+			_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-1"},{"key":"TEST-2"}],"nextPageToken":"p2"}`))
+		case "p2":
+			_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-3"}],"nextPageToken":"p3"}`))
+		default:
+			_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-4"}],"isLast":true}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchJQLFrom("project=TEST", 1, 2, []string{"summary"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "p2"}, tokens)
+	assert.Equal(t, []string{"3", "1"}, limits)
+	assert.Equal(t, 1, counts)
+	assert.Equal(t, &SearchResult{
+		StartAt:       1,
+		MaxResults:    2,
+		Total:         10,
+		Issues:        []*Issue{{Key: "TEST-2"}, {Key: "TEST-3"}},
+		NextPageToken: "p3",
+	}, actual)
+
+	tokens, limits = nil, nil
+
+	actual, err = client.SearchJQLFrom("project=TEST", 2, 10, []string{"summary"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "p2", "p3"}, tokens)
+	assert.Equal(t, []string{"12", "10", "9"}, limits)
+	assert.Equal(t, 1, counts)
+	assert.Equal(t, 4, actual.Total)
+	assert.True(t, actual.IsLast)
+	assert.Equal(t, []*Issue{{Key: "TEST-3"}, {Key: "TEST-4"}}, actual.Issues)
+}
+
+func TestSearchAll(t *testing.T) {
+	var limits []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		limits = append(limits, r.URL.Query().Get("maxResults"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		switch r.URL.Query().Get("nextPageToken") {
+		case "":
+			_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-1"},{"key":"TEST-2"}],"nextPageToken":"p2"}`))
+		default:
+			_, _ = w.Write([]byte(`{"issues":[{"key":"TEST-3"}],"isLast":true}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, DefaultMaxResults: 2}, WithTimeout(3*time.Second))
+
+	issues, err := client.SearchAll("project=TEST", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}, {Key: "TEST-3"}}, issues)
+	assert.Equal(t, []string{"2", "2"}, limits)
+}
+
+func TestSearchAllV2(t *testing.T) {
+	var offsets []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+		offsets = append(offsets, r.URL.Query().Get("startAt"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"issues":[{"key":"TEST-1"},{"key":"TEST-2"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"issues":[{"key":"TEST-3"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, DefaultMaxResults: 2}, WithTimeout(3*time.Second))
+
+	issues, err := client.SearchAllV2("project=TEST", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}, {Key: "TEST-3"}}, issues)
+	assert.Equal(t, []string{"0", "2"}, offsets)
+}
+
+func TestSearchDefaultMaxResults(t *testing.T) {
+	var limit string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit = r.URL.Query().Get("maxResults")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"issues":[]}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		configured int
+		expected   string
+	}{
+		{configured: 0, expected: "100"},
+		{configured: 50, expected: "50"},
+		{configured: 5000, expected: "1000"},
+	}
+
+	for _, tc := range cases {
+		client := NewClient(Config{Server: server.URL, DefaultMaxResults: tc.configured}, WithTimeout(3*time.Second))
+
+		_, err := client.SearchAll("project=TEST", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, limit)
+
+		_, err = client.SearchAllV2("project=TEST", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, limit)
+
+		// An explicit page size is always respected.
+		_, err = client.Search("project=TEST", 0, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, "10", limit)
+
+		_, err = client.Search("project=TEST", 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "0", limit)
+	}
+}
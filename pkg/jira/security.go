@@ -0,0 +1,53 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SecurityLevel holds info of an issue security level.
+type SecurityLevel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetSecurityLevels fetches security levels the user can set on issues of a
+// project using GET /project/{projectKeyOrId}/securitylevel endpoint.
+func (c *Client) GetSecurityLevels(project string) ([]*SecurityLevel, error) {
+	path := fmt.Sprintf("/project/%s/securitylevel", project)
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Levels []*SecurityLevel `json:"levels"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Levels, err
+}
+
+// FindSecurityLevel returns the level with the given id or name from the list,
+// names are matched case-insensitively. It returns nil if there is no match.
+func FindSecurityLevel(levels []*SecurityLevel, level string) *SecurityLevel {
+	for _, l := range levels {
+		if l.ID == level || strings.EqualFold(l.Name, level) {
+			return l
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSecurityLevels(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/TEST/securitylevel", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{
+				"levels": [
+					{"self": "https://test.atlassian.net/rest/api/2/securitylevel/10000", "id": "10000", "name": "Internal", "description": "Employees only"},
+					{"self": "https://test.atlassian.net/rest/api/2/securitylevel/10001", "id": "10001", "name": "Security Team"}
+				]
+			}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSecurityLevels("TEST")
+	assert.NoError(t, err)
+
+	expected := []*SecurityLevel{
+		{ID: "10000", Name: "Internal", Description: "Employees only"},
+		{ID: "10001", Name: "Security Team"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetSecurityLevels("TEST")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestFindSecurityLevel(t *testing.T) {
+	levels := []*SecurityLevel{
+		{ID: "10000", Name: "Internal"},
+		{ID: "10001", Name: "Security Team"},
+	}
+
+	assert.Equal(t, levels[0], FindSecurityLevel(levels, "internal"))
+	assert.Equal(t, levels[1], FindSecurityLevel(levels, "10001"))
+	assert.Nil(t, FindSecurityLevel(levels, "Public"))
+}
+
+func TestEditSecurityLevel(t *testing.T) {
+	data := getRequestDataForEdit(&EditRequest{SecurityLevel: "10000"})
+
+	body, err := json.Marshal(data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"update":{"security":[{"set":{"id":"10000"}}]},"fields":{"parent":{}}}`, string(body))
+}
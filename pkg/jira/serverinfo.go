@@ -6,8 +6,16 @@ import (
 	"net/http"
 )
 
+// Deployment types returned by /serverInfo endpoint.
+const (
+	DeploymentTypeCloud      = "Cloud"
+	DeploymentTypeServer     = "Server"
+	DeploymentTypeDataCenter = "DataCenter"
+)
+
 // ServerInfo struct holds response from /serverInfo endpoint.
 type ServerInfo struct {
+	BaseURL        string `json:"baseUrl"`
 	Version        string `json:"version"`
 	VersionNumbers []int  `json:"versionNumbers"`
 	DeploymentType string `json:"deploymentType"`
@@ -17,15 +25,22 @@ type ServerInfo struct {
 	} `json:"defaultLocale"`
 }
 
+// IsCloud tells if the server is a Jira cloud instance.
+func (s *ServerInfo) IsCloud() bool {
+	return s.DeploymentType == DeploymentTypeCloud
+}
+
 // ServerInfo fetches response from /serverInfo endpoint.
 func (c *Client) ServerInfo() (*ServerInfo, error) {
 	res, err := c.GetV2(context.Background(), "/serverInfo", nil)
 	if err != nil {
 		return nil, err
 	}
-	if res != nil {
-		defer func() { _ = res.Body.Close() }()
+	if res == nil {
+		return nil, ErrEmptyResponse
 	}
+	defer func() { _ = res.Body.Close() }()
+
 	if res.StatusCode != http.StatusOK {
 		return nil, formatUnexpectedResponse(res)
 	}
@@ -36,3 +51,36 @@ func (c *Client) ServerInfo() (*ServerInfo, error) {
 
 	return &info, err
 }
+
+// InstallationType detects whether the server is a cloud or an on-premise installation
+// from the deployment type reported by /serverInfo endpoint. The server info is fetched
+// once and cached in the client, so it is cheap to call before every request.
+func (c *Client) InstallationType() (string, error) {
+	c.infoMu.Lock()
+	defer c.infoMu.Unlock()
+
+	if c.info == nil {
+		info, err := c.ServerInfo()
+		if err != nil {
+			return "", err
+		}
+		c.info = info
+	}
+
+	if c.info.IsCloud() {
+		return InstallationTypeCloud, nil
+	}
+	return InstallationTypeLocal, nil
+}
+
+// resolveVersion returns the api version to use for the requested version. In auto
+// mode, v3 is replaced with v2 if the server is an on-premise installation.
+func (c *Client) resolveVersion(ver string) string {
+	if c.apiVer != APIVersionAuto || ver != apiVersion3 {
+		return ver
+	}
+	if it, err := c.InstallationType(); err == nil && it == InstallationTypeLocal {
+		return apiVersion2
+	}
+	return ver
+}
@@ -35,6 +35,7 @@ func TestServerInfo(t *testing.T) {
 	assert.NoError(t, err)
 
 	expected := &ServerInfo{
+		BaseURL:        "https://demo.atlassian.net",
 		Version:        "1001.0.0-SNAPSHOT",
 		VersionNumbers: []int{1001, 0, 0},
 		DeploymentType: "Cloud",
@@ -50,3 +51,80 @@ func TestServerInfo(t *testing.T) {
 	_, err = client.ServerInfo()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestInstallationType(t *testing.T) {
+	var calls int
+
+	deploymentType := DeploymentTypeDataCenter
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/serverInfo", r.URL.Path)
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"deploymentType":"` + deploymentType + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	it, err := client.InstallationType()
+	assert.NoError(t, err)
+	assert.Equal(t, InstallationTypeLocal, it)
+
+	it, err = client.InstallationType()
+	assert.NoError(t, err)
+	assert.Equal(t, InstallationTypeLocal, it)
+	assert.Equal(t, 1, calls)
+
+	deploymentType = DeploymentTypeCloud
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	it, err = client.InstallationType()
+	assert.NoError(t, err)
+	assert.Equal(t, InstallationTypeCloud, it)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAPIVersionAuto(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/2/serverInfo":
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"deploymentType":"Server"}`))
+		case "/rest/api/2/issue/TEST-1/assignee":
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{"description":"h1. Wiki"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, APIVersion: APIVersionAuto}, WithTimeout(3*time.Second))
+
+	iss, err := client.GetIssue("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "h1. Wiki", iss.Fields.Description)
+
+	assert.NoError(t, client.AssignIssue("TEST-1", "jdoe"))
+
+	assert.Equal(t, []string{
+		"/rest/api/2/serverInfo",
+		"/rest/api/2/issue/TEST-1",
+		"/rest/api/2/issue/TEST-1/assignee",
+	}, paths)
+
+	paths = nil
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err = client.GetIssueRaw("TEST-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/rest/api/3/issue/TEST-1"}, paths)
+}
@@ -15,6 +15,11 @@ const (
 	SprintStateFuture = "future"
 )
 
+const (
+	sprintPageSize       = 50
+	sprintIssuesPageSize = 100
+)
+
 // SprintResult holds response from /board/{boardID}/sprint endpoint.
 type SprintResult struct {
 	MaxResults int       `json:"maxResults"`
@@ -51,6 +56,34 @@ func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult
 	return &out, err
 }
 
+// GetSprints fetches all sprints of a board in the given state, following pagination
+// until every sprint is read. State can be a comma separated list, eg: active,future.
+// Sprints in all states are returned if the state is empty.
+func (c *Client) GetSprints(boardID int, state string) ([]*Sprint, error) {
+	var (
+		qp      string
+		sprints []*Sprint
+	)
+
+	if state != "" {
+		qp = "state=" + url.QueryEscape(state)
+	}
+
+	for {
+		res, err := c.Sprints(boardID, qp, len(sprints), sprintPageSize)
+		if err != nil {
+			return nil, err
+		}
+		sprints = append(sprints, res.Sprints...)
+
+		if res.IsLast || len(res.Sprints) == 0 {
+			break
+		}
+	}
+
+	return sprints, nil
+}
+
 // GetSprint returns a single sprint given an ID.
 func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
 	res, err := c.GetV1(
@@ -123,6 +156,108 @@ func (c *Client) EndSprint(sprintID int) error {
 	return nil
 }
 
+// CreateSprint creates a new future sprint in a board using POST /sprint endpoint.
+// Dates are optional and should be in ISO 8601 format, eg: 2024-01-31T10:00:00.000Z.
+func (c *Client) CreateSprint(boardID int, name, startDate, endDate, goal string) (*Sprint, error) {
+	body, err := json.Marshal(&struct {
+		Name      string `json:"name"`
+		BoardID   int    `json:"originBoardId"`
+		StartDate string `json:"startDate,omitempty"`
+		EndDate   string `json:"endDate,omitempty"`
+		Goal      string `json:"goal,omitempty"`
+	}{
+		Name:      name,
+		BoardID:   boardID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Goal:      goal,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV1(context.Background(), "/sprint", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateSprintState moves a sprint to the given state.
+//
+// Starting a sprint requires the sprint to have both start and end dates
+// and no other active sprint in its board. Closing a sprint is the same as EndSprint.
+func (c *Client) UpdateSprintState(sprintID int, state string) error {
+	switch state {
+	case SprintStateClosed:
+		return c.EndSprint(sprintID)
+	case SprintStateActive:
+	default:
+		return fmt.Errorf("invalid sprint state %q: must be one of %s, %s", state, SprintStateActive, SprintStateClosed)
+	}
+
+	sprint, err := c.GetSprint(sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.Status != SprintStateFuture {
+		return fmt.Errorf("sprint %d is %s, only future sprints can be started", sprintID, sprint.Status)
+	}
+	if sprint.StartDate == "" || sprint.EndDate == "" {
+		return fmt.Errorf("sprint %d must have a start and an end date to be started", sprintID)
+	}
+	if sprint.BoardID != 0 {
+		active, err := c.GetSprints(sprint.BoardID, SprintStateActive)
+		if err != nil {
+			return err
+		}
+		if len(active) > 0 {
+			return fmt.Errorf("sprint %d is already active in board %d", active[0].ID, sprint.BoardID)
+		}
+	}
+
+	body, err := json.Marshal(&struct {
+		State string `json:"state"`
+	}{State: state})
+	if err != nil {
+		return err
+	}
+
+	// POST to the sprint endpoint does a partial update unlike PUT.
+	res, err := c.PostV1(context.Background(), fmt.Sprintf("/sprint/%d", sprintID), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
 // SprintsInBoards fetches sprints across given board IDs.
 //
 // qp is an additional query parameters in key, value pair format, eg: state=closed.
@@ -191,6 +326,82 @@ func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint) (*Sear
 	return &out, err
 }
 
+// GetSprintIssues fetches all issues in the given sprint, following pagination
+// until every issue is read. The jql is an optional additional filter.
+func (c *Client) GetSprintIssues(sprintID int, jql string) (*SearchResult, error) {
+	var out SearchResult
+
+	for {
+		res, err := c.SprintIssues(sprintID, jql, uint(len(out.Issues)), sprintIssuesPageSize)
+		if err != nil {
+			return nil, err
+		}
+		out.Issues = append(out.Issues, res.Issues...)
+		out.Total = res.Total
+
+		if len(res.Issues) == 0 || len(out.Issues) >= res.Total {
+			break
+		}
+	}
+	out.MaxResults = len(out.Issues)
+
+	return &out, nil
+}
+
+// SprintIssuePoints fetches the numeric value of the given field, eg: a story points
+// custom field, for every issue in the sprint. Issues without a value are skipped.
+func (c *Client) SprintIssuePoints(sprintID int, field string) (map[string]float64, error) {
+	points := make(map[string]float64)
+
+	for from := 0; ; {
+		path := fmt.Sprintf(
+			"/sprint/%d/issue?startAt=%d&maxResults=%d&fields=%s",
+			sprintID, from, sprintIssuesPageSize, url.QueryEscape(field),
+		)
+
+		res, err := c.GetV1(context.Background(), path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		if res.StatusCode != http.StatusOK {
+			err = formatUnexpectedResponse(res)
+			_ = res.Body.Close()
+			return nil, err
+		}
+
+		var out struct {
+			Total  int `json:"total"`
+			Issues []struct {
+				Key    string         `json:"key"`
+				Fields map[string]any `json:"fields"`
+			} `json:"issues"`
+		}
+
+		err = json.NewDecoder(res.Body).Decode(&out)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, iss := range out.Issues {
+			if p, ok := iss.Fields[field].(float64); ok {
+				points[iss.Key] = p
+			}
+		}
+
+		from += len(out.Issues)
+		if len(out.Issues) == 0 || from >= out.Total {
+			break
+		}
+	}
+
+	return points, nil
+}
+
 // SprintIssuesAdd adds issues to the sprint.
 func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 	path := fmt.Sprintf("/sprint/%s/issue", id)
@@ -104,6 +104,76 @@ func TestSprints(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestGetSprints(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2/sprint", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, url.Values{
+				"state":      []string{"active,future"},
+				"startAt":    []string{"0"},
+				"maxResults": []string{"50"},
+			}, r.URL.Query())
+
+			resp, err := os.ReadFile("./testdata/sprints.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSprints(2, "active,future")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 5)
+	assert.Equal(t, "Sprint 1", actual[0].Name)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetSprints(2, "active,future")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetSprintsMultiplePages(t *testing.T) {
+	var startAt []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2/sprint", r.URL.Path)
+
+		qs := r.URL.Query()
+		startAt = append(startAt, qs.Get("startAt"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch qs.Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"maxResults":2,"startAt":0,"isLast":false,"values":[{"id":1,"name":"Sprint 1"},{"id":2,"name":"Sprint 2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"maxResults":2,"startAt":2,"isLast":true,"values":[{"id":3,"name":"Sprint 3"}]}`))
+		default:
+			t.Errorf("unexpected startAt %s", qs.Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSprints(2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "2"}, startAt)
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "Sprint 3", actual[2].Name)
+}
+
 func TestSprintsInBoards(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/rest/agile/1.0/board/2/sprint", r.URL.Path)
@@ -239,9 +309,7 @@ func TestSprintIssues(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 1},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
+					Status:  IssueStatus{Name: "To Do"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -262,9 +330,7 @@ func TestSprintIssues(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: true, WatchCount: 12},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "In Progress"},
+					Status:  IssueStatus{Name: "In Progress"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -291,9 +357,7 @@ func TestSprintIssues(t *testing.T) {
 						IsWatching bool `json:"isWatching"`
 						WatchCount int  `json:"watchCount"`
 					}{IsWatching: false, WatchCount: 3},
-					Status: struct {
-						Name string `json:"name"`
-					}{Name: "Done"},
+					Status:  IssueStatus{Name: "Done"},
 					Created: "2020-12-03T14:05:20.974+0100",
 					Updated: "2020-12-03T14:05:20.974+0100",
 				},
@@ -416,3 +480,164 @@ func TestEndSprint(t *testing.T) {
 	err = client.EndSprint(5)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"name":"Sprint 6","startDate":"2024-01-01T09:00:00.000Z","endDate":"2024-01-14T17:00:00.000Z","originBoardId":3,"goal":"Ship it"}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id":6,"name":"Sprint 6","state":"future","originBoardId":3,"goal":"Ship it"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint, err := client.CreateSprint(3, "Sprint 6", "2024-01-01T09:00:00.000Z", "2024-01-14T17:00:00.000Z", "Ship it")
+	assert.NoError(t, err)
+	assert.Equal(t, &Sprint{ID: 6, Name: "Sprint 6", Status: "future", BoardID: 3, Goal: "Ship it"}, sprint)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateSprint(3, "Sprint 6", "2024-01-01T09:00:00.000Z", "2024-01-14T17:00:00.000Z", "Ship it")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateSprintState(t *testing.T) {
+	var (
+		sprint       string
+		activeExists bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/agile/1.0/sprint/6":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(sprint))
+		case r.Method == "GET" && r.URL.Path == "/rest/agile/1.0/board/3/sprint":
+			assert.Equal(t, "active", r.URL.Query().Get("state"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			if activeExists {
+				_, _ = w.Write([]byte(`{"isLast":true,"values":[{"id":5,"state":"active"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"isLast":true,"values":[]}`))
+			}
+		case r.Method == "POST" && r.URL.Path == "/rest/agile/1.0/sprint/6":
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+			assert.JSONEq(t, `{"state":"active"}`, actualBody.String())
+
+			w.WriteHeader(200)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint = `{"id":6,"state":"future","startDate":"2024-01-01T09:00:00.000Z","endDate":"2024-01-14T17:00:00.000Z","originBoardId":3}`
+	assert.NoError(t, client.UpdateSprintState(6, SprintStateActive))
+
+	activeExists = true
+	assert.EqualError(t, client.UpdateSprintState(6, SprintStateActive), "sprint 5 is already active in board 3")
+
+	sprint = `{"id":6,"state":"future","originBoardId":3}`
+	assert.EqualError(t, client.UpdateSprintState(6, SprintStateActive), "sprint 6 must have a start and an end date to be started")
+
+	assert.EqualError(t, client.UpdateSprintState(6, "invalid"), `invalid sprint state "invalid": must be one of active, closed`)
+}
+
+func TestGetSprintIssues(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/2/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		qs := r.URL.Query()
+		assert.Equal(t, "status = Done", qs.Get("jql"))
+		assert.Equal(t, "100", qs.Get("maxResults"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch qs.Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"total":3,"issues":[{"key":"TEST-1"},{"key":"TEST-2"}]}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"total":3,"issues":[{"key":"TEST-3"}]}`))
+		default:
+			t.Errorf("unexpected startAt %s", qs.Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSprintIssues(2, "status = Done")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, actual.Total)
+	assert.Len(t, actual.Issues, 3)
+	assert.Equal(t, "TEST-3", actual.Issues[2].Key)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetSprintIssues(2, "status = Done")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestSprintIssuePoints(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/2/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		assert.Equal(t, "customfield_10016", r.URL.Query().Get("fields"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"total":3,"issues":[
+			{"key":"TEST-1","fields":{"customfield_10016":3}},
+			{"key":"TEST-2","fields":{"customfield_10016":null}},
+			{"key":"TEST-3","fields":{"customfield_10016":0.5}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SprintIssuePoints(2, "customfield_10016")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"TEST-1": 3, "TEST-3": 0.5}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SprintIssuePoints(2, "customfield_10016")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
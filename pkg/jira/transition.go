@@ -26,7 +26,8 @@ type TransitionRequestUpdate struct {
 // TransitionRequestFields struct holds a list of issue screen fields to update along with sub-fields.
 type TransitionRequestFields struct {
 	Assignee *struct {
-		Name string `json:"name"`
+		Name      string `json:"name,omitempty"`      // For local installation.
+		AccountID string `json:"accountId,omitempty"` // For cloud installation.
 	} `json:"assignee,omitempty"`
 	Resolution *struct {
 		Name string `json:"name"`
@@ -46,15 +47,25 @@ type transitionResponse struct {
 
 // Transitions fetches valid transitions for an issue using v3 version of the GET /issue/{key}/transitions endpoint.
 func (c *Client) Transitions(key string) ([]*Transition, error) {
-	return c.transitions(key, apiVersion3)
+	return c.transitions(context.Background(), key, apiVersion3)
+}
+
+// TransitionsCtx is the same as Transitions but uses the given context for the request.
+func (c *Client) TransitionsCtx(ctx context.Context, key string) ([]*Transition, error) {
+	return c.transitions(ctx, key, apiVersion3)
 }
 
 // TransitionsV2 fetches valid transitions for an issue using v2 version of the GET /issue/{key}/transitions endpoint.
 func (c *Client) TransitionsV2(key string) ([]*Transition, error) {
-	return c.transitions(key, apiVersion2)
+	return c.transitions(context.Background(), key, apiVersion2)
 }
 
-func (c *Client) transitions(key, ver string) ([]*Transition, error) {
+// TransitionsV2Ctx is the same as TransitionsV2 but uses the given context for the request.
+func (c *Client) TransitionsV2Ctx(ctx context.Context, key string) ([]*Transition, error) {
+	return c.transitions(ctx, key, apiVersion2)
+}
+
+func (c *Client) transitions(ctx context.Context, key, ver string) ([]*Transition, error) {
 	path := fmt.Sprintf("/issue/%s/transitions", key)
 
 	var (
@@ -64,9 +75,9 @@ func (c *Client) transitions(key, ver string) ([]*Transition, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(ctx, path, nil)
 	}
 
 	if err != nil {
@@ -90,6 +101,11 @@ func (c *Client) transitions(key, ver string) ([]*Transition, error) {
 
 // Transition moves issue from one state to another using POST /issue/{key}/transitions endpoint.
 func (c *Client) Transition(key string, data *TransitionRequest) (int, error) {
+	return c.TransitionCtx(context.Background(), key, data)
+}
+
+// TransitionCtx is the same as Transition but uses the given context for the request.
+func (c *Client) TransitionCtx(ctx context.Context, key string, data *TransitionRequest) (int, error) {
 	body, err := json.Marshal(&data)
 	if err != nil {
 		return 0, err
@@ -97,7 +113,7 @@ func (c *Client) Transition(key string, data *TransitionRequest) (int, error) {
 
 	path := fmt.Sprintf("/issue/%s/transitions", key)
 
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// compressionTransport asks the server for a compressed response and decodes
+// gzip and deflate encoded response bodies.
+//
+// Standard transport only decompresses gzip responses if it has set the
+// Accept-Encoding header itself, so we handle decoding here to also support
+// deflate and keep it working if a caller sets the header explicitly.
+type compressionTransport struct {
+	base http.RoundTripper
+}
+
+func (t *compressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") == "" {
+		// Clone the request as RoundTripper must not modify the given request.
+		req = req.Clone(req.Context())
+		req.Header.Set("Accept-Encoding", "gzip, deflate")
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res == nil {
+		return res, err
+	}
+
+	var open func(io.Reader) (io.ReadCloser, error)
+
+	switch strings.ToLower(strings.TrimSpace(res.Header.Get("Content-Encoding"))) {
+	case "gzip":
+		open = func(r io.Reader) (io.ReadCloser, error) { return gzip.NewReader(r) }
+	case "deflate":
+		open = zlib.NewReader
+	default:
+		return res, nil
+	}
+
+	res.Body = &decompressReader{body: res.Body, open: open}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+
+	return res, nil
+}
+
+// decompressReader lazily initializes the decompressor on the first read
+// so that empty bodies, eg: in 204 responses, don't result in an error.
+type decompressReader struct {
+	body   io.ReadCloser
+	open   func(io.Reader) (io.ReadCloser, error)
+	reader io.ReadCloser
+	err    error
+}
+
+func (d *decompressReader) Read(p []byte) (int, error) {
+	if d.reader == nil && d.err == nil {
+		d.reader, d.err = d.open(d.body)
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return d.reader.Read(p)
+}
+
+func (d *decompressReader) Close() error {
+	if d.reader != nil {
+		_ = d.reader.Close()
+	}
+	return d.body.Close()
+}
+
+// traceTransport logs method, URL, status and timing of each request to the writer.
+// Request and response bodies are logged as well if body is set. Authorization
+// header is never logged.
+type traceTransport struct {
+	base http.RoundTripper
+	w    io.Writer
+	body bool
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	_, _ = fmt.Fprintf(t.w, "--> %s %s\n", req.Method, req.URL.Redacted())
+	if t.body && req.Body != nil && req.GetBody != nil {
+		if b, err := req.GetBody(); err == nil {
+			t.printBody(b)
+		}
+	}
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		_, _ = fmt.Fprintf(t.w, "<-- %s %s error: %s (%s)\n", req.Method, req.URL.Redacted(), err, elapsed)
+		return res, err
+	}
+	_, _ = fmt.Fprintf(t.w, "<-- %s %s %s (%s)\n", req.Method, req.URL.Redacted(), res.Status, elapsed)
+
+	if t.body && res.Body != nil {
+		b, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		res.Body = io.NopCloser(bytes.NewReader(b))
+		if err != nil {
+			return res, err
+		}
+		t.printBody(io.NopCloser(bytes.NewReader(b)))
+	}
+
+	return res, nil
+}
+
+func (t *traceTransport) printBody(r io.ReadCloser) {
+	defer func() { _ = r.Close() }()
+
+	b, _ := io.ReadAll(r)
+	if len(b) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(t.w, "%s\n", bytes.TrimSpace(b))
+}
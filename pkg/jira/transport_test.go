@@ -0,0 +1,123 @@
+package jira
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressionTransport(t *testing.T) {
+	resp, err := os.ReadFile("./testdata/issue-2.json")
+	assert.NoError(t, err)
+
+	var encoding string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip, deflate", r.Header.Get("Accept-Encoding"))
+
+		var buf bytes.Buffer
+
+		switch encoding {
+		case "gzip":
+			zw := gzip.NewWriter(&buf)
+			_, _ = zw.Write(resp)
+			_ = zw.Close()
+		case "deflate":
+			zw := zlib.NewWriter(&buf)
+			_, _ = zw.Write(resp)
+			_ = zw.Close()
+		default:
+			buf.Write(resp)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if encoding != "" {
+			w.Header().Set("Content-Encoding", encoding)
+		}
+		w.WriteHeader(200)
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	for _, enc := range []string{"", "gzip", "deflate"} {
+		encoding = enc
+
+		raw, err := client.GetIssueV2Raw("TEST-2")
+		assert.NoError(t, err, enc)
+		assert.True(t, json.Valid([]byte(raw)), enc)
+		assert.JSONEq(t, string(resp), raw, enc)
+	}
+}
+
+func TestCompressionTransportEmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssue("TEST-1", false)
+	assert.NoError(t, err)
+}
+
+func TestTraceTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id":"10000"}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	client := NewClient(
+		Config{Server: server.URL, Login: "user", APIToken: "secret"},
+		WithTimeout(3*time.Second), WithDebug(&out),
+	)
+
+	res, err := client.Post(context.Background(), "/issue", []byte(`{"fields":{}}`), nil)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "--> POST "+server.URL+"/rest/api/3/issue", lines[0])
+	assert.Regexp(t, `^<-- POST .+/rest/api/3/issue 201 Created \(\d+m?s\)$`, lines[1])
+	assert.NotContains(t, out.String(), "secret")
+
+	out.Reset()
+
+	client = NewClient(
+		Config{Server: server.URL, Login: "user", APIToken: "secret"},
+		WithTimeout(3*time.Second), WithDebug(&out), WithDebugBody(true),
+	)
+
+	res, err = client.Post(context.Background(), "/issue", []byte(`{"fields":{}}`), nil)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"10000"}`, string(body))
+	_ = res.Body.Close()
+
+	lines = strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 4)
+	assert.Equal(t, `{"fields":{}}`, lines[1])
+	assert.Equal(t, `{"id":"10000"}`, lines[3])
+	assert.NotContains(t, out.String(), "secret")
+}
@@ -2,6 +2,9 @@ package jira
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -33,7 +36,16 @@ type Project struct {
 	Lead struct {
 		Name string `json:"displayName"`
 	} `json:"lead"`
-	Type string `json:"style"`
+	Type           string `json:"style"`
+	ProjectTypeKey string `json:"projectTypeKey,omitempty"`
+
+	// Following fields are only populated by GetProject.
+	ID          string            `json:"id,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Components  []*Component      `json:"components,omitempty"`
+	Versions    []*ProjectVersion `json:"versions,omitempty"`
+	IssueTypes  []*IssueType      `json:"issueTypes,omitempty"`
+	Roles       map[string]string `json:"roles,omitempty"`
 }
 
 // ProjectVersion holds project version info.
@@ -44,13 +56,31 @@ type ProjectVersion struct {
 	Name        string      `json:"name"`
 	ProjectID   int         `json:"projectId"`
 	Released    bool        `json:"released"`
+	ReleaseDate string      `json:"releaseDate,omitempty"`
+}
+
+// Component holds project component info.
+type Component struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Lead        struct {
+		Name string `json:"displayName"`
+	} `json:"lead"`
 }
 
 // Board holds board info.
 type Board struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
-	Type string `json:"type"`
+	ID       int            `json:"id"`
+	Name     string         `json:"name"`
+	Type     string         `json:"type"`
+	Location *BoardLocation `json:"location,omitempty"`
+}
+
+// BoardLocation holds info of the project a board belongs to.
+type BoardLocation struct {
+	ProjectID  int    `json:"projectId"`
+	ProjectKey string `json:"projectKey"`
 }
 
 // Epic holds epic info.
@@ -90,9 +120,7 @@ type IssueFields struct {
 		IsWatching bool `json:"isWatching"`
 		WatchCount int  `json:"watchCount"`
 	} `json:"watches"`
-	Status struct {
-		Name string `json:"name"`
-	} `json:"status"`
+	Status     IssueStatus `json:"status"`
 	Components []struct {
 		Name string `json:"name"`
 	} `json:"components"`
@@ -111,7 +139,7 @@ type IssueFields struct {
 		} `json:"comments"`
 		Total int `json:"total"`
 	} `json:"comment"`
-	Subtasks   []Issue
+	Subtasks   []Issue `json:"subtasks"`
 	IssueLinks []struct {
 		ID       string `json:"id"`
 		LinkType struct {
@@ -122,8 +150,23 @@ type IssueFields struct {
 		InwardIssue  *Issue `json:"inwardIssue,omitempty"`
 		OutwardIssue *Issue `json:"outwardIssue,omitempty"`
 	} `json:"issueLinks"`
-	Created string `json:"created"`
-	Updated string `json:"updated"`
+	Attachments          []Attachment `json:"attachment"`
+	TimeOriginalEstimate int          `json:"timeoriginalestimate"` // In seconds.
+	TimeEstimate         int          `json:"timeestimate"`         // Remaining estimate in seconds.
+	TimeSpent            int          `json:"timespent"`            // In seconds.
+	Created              string       `json:"created"`
+	Updated              string       `json:"updated"`
+}
+
+// Attachment holds issue attachment info.
+type Attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Author   User   `json:"author"`
+	Created  string `json:"created"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Content  string `json:"content"`
 }
 
 // Field holds field info.
@@ -136,6 +179,29 @@ type Field struct {
 		Items    string `json:"items,omitempty"`
 		FieldID  int    `json:"customId,omitempty"`
 	} `json:"schema"`
+	Searchable bool `json:"searchable"`
+	// ClauseNames holds names the field can be referenced with in JQL, eg: cf[10111] and "Story Points".
+	ClauseNames []string `json:"clauseNames,omitempty"`
+	// SearcherKey is the key of the searcher used to query custom fields, it is not returned for system fields.
+	SearcherKey string `json:"searcherKey,omitempty"`
+}
+
+// JQLClause returns the name to reference the field with in JQL. Clause names that
+// don't need quoting are preferred as they are unambiguous, eg: fixVersion or cf[10111].
+// Field id is used if the server didn't return any clause names.
+func (f *Field) JQLClause() string {
+	if len(f.ClauseNames) == 0 {
+		if f.Custom && f.Schema.FieldID > 0 {
+			return fmt.Sprintf("cf[%d]", f.Schema.FieldID)
+		}
+		return f.ID
+	}
+	for _, n := range f.ClauseNames {
+		if !strings.ContainsAny(n, " \"'") {
+			return n
+		}
+	}
+	return strconv.Quote(f.ClauseNames[0])
 }
 
 // IssueTypeField holds issue field info.
@@ -157,6 +223,58 @@ type IssueType struct {
 	Subtask bool   `json:"subtask"`
 }
 
+// Status category keys, the categories are shown as To Do, In Progress and Done in the UI.
+const (
+	StatusCategoryToDo       = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
+// IssueStatus holds issue status info.
+type IssueStatus struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"statusCategory"`
+}
+
+// RemoteLink holds remote issue link info.
+type RemoteLink struct {
+	ID           int                    `json:"id,omitempty"`
+	GlobalID     string                 `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplication `json:"application,omitempty"`
+	Relationship string                 `json:"relationship,omitempty"`
+	Object       RemoteLinkObject       `json:"object"`
+}
+
+// RemoteLinkApplication holds info of the application the remote link points to.
+type RemoteLinkApplication struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RemoteLinkObject holds info of the remote object a remote link points to.
+type RemoteLinkObject struct {
+	URL     string            `json:"url"`
+	Title   string            `json:"title"`
+	Summary string            `json:"summary,omitempty"`
+	Icon    *RemoteLinkIcon   `json:"icon,omitempty"`
+	Status  *RemoteLinkStatus `json:"status,omitempty"`
+}
+
+// RemoteLinkIcon holds remote link icon info.
+type RemoteLinkIcon struct {
+	URL   string `json:"url16x16,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// RemoteLinkStatus holds status of the remote object.
+type RemoteLinkStatus struct {
+	Resolved bool            `json:"resolved"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty"`
+}
+
 // IssueLinkType holds issue link type info.
 type IssueLinkType struct {
 	ID      string `json:"id"`
@@ -174,6 +292,7 @@ type Sprint struct {
 	EndDate      string `json:"endDate"`
 	CompleteDate string `json:"completeDate,omitempty"`
 	BoardID      int    `json:"originBoardId,omitempty"`
+	Goal         string `json:"goal,omitempty"`
 }
 
 // Transition holds issue transition info.
@@ -103,3 +103,79 @@ func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error)
 	}
 	return out, nil
 }
+
+// userSearchPageSize is the max number of users the /user/search endpoint returns in a single page.
+const userSearchPageSize = 50
+
+// SearchUsers searches for users using v3 version of the GET /user/search endpoint.
+// Results are fetched page by page until maxResults users are collected.
+//
+// Email may be empty for users with restricted profile visibility on cloud instances.
+func (c *Client) SearchUsers(query string, maxResults int) ([]*User, error) {
+	return c.searchUsers(fmt.Sprintf("query=%s", url.QueryEscape(query)), maxResults, apiVersion3)
+}
+
+// SearchUsersV2 searches for users using v2 version of the GET /user/search endpoint.
+func (c *Client) SearchUsersV2(query string, maxResults int) ([]*User, error) {
+	// Older Jira server versions don't support the `query` param in this endpoint.
+	return c.searchUsers(fmt.Sprintf("username=%s", url.QueryEscape(query)), maxResults, apiVersion2)
+}
+
+func (c *Client) searchUsers(qp string, maxResults int, ver string) ([]*User, error) {
+	if maxResults <= 0 {
+		return nil, ErrInvalidSearchOption
+	}
+
+	users := make([]*User, 0)
+
+	for from := 0; len(users) < maxResults; {
+		limit := min(maxResults-len(users), userSearchPageSize)
+
+		page, err := c.searchUsersPage(qp, from, limit, ver)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, page...)
+
+		if len(page) < limit {
+			break
+		}
+		from += len(page)
+	}
+
+	return users, nil
+}
+
+func (c *Client) searchUsersPage(qp string, from, limit int, ver string) ([]*User, error) {
+	var (
+		res *http.Response
+		err error
+	)
+
+	path := fmt.Sprintf("/user/search?%s&startAt=%d&maxResults=%d", qp, from, limit)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.GetV2(context.Background(), path, nil)
+	default:
+		res, err = c.Get(context.Background(), path, nil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*User
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -1,10 +1,13 @@
 package jira
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -129,3 +132,88 @@ func TestUserSearchV2(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestSearchUsers(t *testing.T) {
+	const totalUsers = 55
+
+	var (
+		unexpectedStatusCode bool
+		requests             []url.Values
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user/search", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+		requests = append(requests, r.URL.Query())
+
+		from, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+
+		users := make([]*User, 0, limit)
+		for i := from; i < min(from+limit, totalUsers); i++ {
+			users = append(users, &User{AccountID: fmt.Sprintf("a%d", i), DisplayName: fmt.Sprintf("User %d", i)})
+		}
+		resp, err := json.Marshal(users)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchUsers("doe", 10)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 10)
+	assert.Equal(t, []url.Values{
+		{"query": []string{"doe"}, "startAt": []string{"0"}, "maxResults": []string{"10"}},
+	}, requests)
+
+	// Results are paginated until max results are collected or there are no more users.
+	requests = nil
+
+	actual, err = client.SearchUsers("doe", 100)
+	assert.NoError(t, err)
+	assert.Len(t, actual, totalUsers)
+	assert.Equal(t, "a54", actual[totalUsers-1].AccountID)
+	assert.Equal(t, []url.Values{
+		{"query": []string{"doe"}, "startAt": []string{"0"}, "maxResults": []string{"50"}},
+		{"query": []string{"doe"}, "startAt": []string{"50"}, "maxResults": []string{"50"}},
+	}, requests)
+
+	_, err = client.SearchUsers("doe", 0)
+	assert.Error(t, ErrInvalidSearchOption, err)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SearchUsers("doe", 10)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestSearchUsersV2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/user/search", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"username":   []string{"doe"},
+			"startAt":    []string{"0"},
+			"maxResults": []string{"5"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"name": "doe", "displayName": "Jon Doe", "active": true}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchUsersV2("doe", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []*User{{Name: "doe", DisplayName: "Jon Doe", Active: true}}, actual)
+}
@@ -43,6 +43,12 @@ func (j *JQL) Watching() *JQL {
 	return j
 }
 
+// Mine search through issues assigned to the current user.
+func (j *JQL) Mine() *JQL {
+	j.filters = append(j.filters, "assignee=currentUser()")
+	return j
+}
+
 // FilterBy filters with a given field.
 //
 // If the value is `x`, it construct the query with IS EMPTY operator, uses equals otherwise.
@@ -117,6 +123,22 @@ func (j *JQL) Lt(field, value string, wrap bool) *JQL {
 	return j
 }
 
+// Lte is a less than and equals filter.
+func (j *JQL) Lte(field, value string, wrap bool) *JQL {
+	if field != "" && value != "" {
+		var q string
+
+		if wrap {
+			q = fmt.Sprintf("%s<=%q", field, value)
+		} else {
+			q = fmt.Sprintf("%s<=%s", field, value)
+		}
+
+		j.filters = append(j.filters, q)
+	}
+	return j
+}
+
 // In constructs a query with IN clause.
 func (j *JQL) In(field string, value ...string) *JQL {
 	n := len(value)
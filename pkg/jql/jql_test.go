@@ -46,6 +46,15 @@ func TestJQL(t *testing.T) {
 			},
 			expected: "project=\"TEST\" issue IN watchedIssues()",
 		},
+		{
+			name: "it queries issues assigned to the current user",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.Mine()
+				return jql
+			},
+			expected: "project=\"TEST\" assignee=currentUser()",
+		},
 		{
 			name: "it queries history and watched issues in order",
 			initialize: func() *JQL {
@@ -0,0 +1,211 @@
+package md
+
+import (
+	"strings"
+
+	bf "github.com/russross/blackfriday/v2"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+// ToADF translates CommonMark to Atlassian document format.
+//
+// Paragraphs, headings, emphasis, strikethrough, links, inline code, code blocks,
+// block quotes, tables and nested lists are supported. Images are translated to
+// links as media in ADF requires the file to be uploaded to Jira first.
+func ToADF(md string) (*adf.ADF, error) {
+	doc := adf.ADF{
+		Version: 1,
+		DocType: "doc",
+		Content: []*adf.Node{},
+	}
+
+	md = strings.ReplaceAll(md, "\r\n", "\n")
+	if strings.TrimSpace(md) == "" {
+		return &doc, nil
+	}
+
+	r := bf.New(bf.WithExtensions(bf.CommonExtensions))
+	root := r.Parse([]byte(md))
+
+	doc.Content = toADFBlocks(root)
+
+	return &doc, nil
+}
+
+func toADFBlocks(parent *bf.Node) []*adf.Node {
+	var out []*adf.Node
+	for n := parent.FirstChild; n != nil; n = n.Next {
+		if b := toADFBlock(n); b != nil {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func toADFBlock(n *bf.Node) *adf.Node {
+	switch n.Type {
+	case bf.Paragraph:
+		return &adf.Node{NodeType: adf.NodeParagraph, Content: toADFInlines(n, nil)}
+	case bf.Heading:
+		return &adf.Node{
+			NodeType:   adf.NodeHeading,
+			Attributes: map[string]any{"level": n.Level},
+			Content:    toADFInlines(n, nil),
+		}
+	case bf.BlockQuote:
+		return &adf.Node{NodeType: adf.NodeBlockquote, Content: toADFBlocks(n)}
+	case bf.List:
+		return toADFList(n)
+	case bf.CodeBlock:
+		return toADFCodeBlock(n)
+	case bf.HorizontalRule:
+		return &adf.Node{NodeType: adf.NodeRule}
+	case bf.Table:
+		return toADFTable(n)
+	case bf.HTMLBlock:
+		return &adf.Node{NodeType: adf.NodeParagraph, Content: toADFText(string(n.Literal), nil)}
+	}
+
+	// Inline nodes are not expected at the block level, wrap them in a paragraph just in case.
+	if inline := toADFInline(n, nil); len(inline) > 0 {
+		return &adf.Node{NodeType: adf.NodeParagraph, Content: inline}
+	}
+	return nil
+}
+
+func toADFList(n *bf.Node) *adf.Node {
+	list := adf.Node{NodeType: adf.NodeBulletList}
+	if n.ListFlags&bf.ListTypeOrdered != 0 {
+		list.NodeType = adf.NodeOrderedList
+	}
+
+	for item := n.FirstChild; item != nil; item = item.Next {
+		if item.Type != bf.Item {
+			continue
+		}
+
+		li := adf.Node{NodeType: adf.ChildNodeListItem}
+		for c := item.FirstChild; c != nil; c = c.Next {
+			if b := toADFBlock(c); b != nil {
+				li.Content = append(li.Content, b)
+			}
+		}
+		// List item must have at least one child in ADF.
+		if len(li.Content) == 0 {
+			li.Content = append(li.Content, &adf.Node{NodeType: adf.NodeParagraph})
+		}
+		list.Content = append(list.Content, &li)
+	}
+
+	return &list
+}
+
+func toADFCodeBlock(n *bf.Node) *adf.Node {
+	code := adf.Node{NodeType: adf.NodeCodeBlock}
+
+	if lang := strings.TrimSpace(string(n.Info)); lang != "" {
+		code.Attributes = map[string]any{"language": strings.Fields(lang)[0]}
+	}
+	if text := strings.TrimSuffix(string(n.Literal), "\n"); text != "" {
+		code.Content = []*adf.Node{{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: text}}}
+	}
+
+	return &code
+}
+
+func toADFTable(n *bf.Node) *adf.Node {
+	table := adf.Node{NodeType: adf.NodeTable}
+
+	// Table contains a head and a body that in turn contain rows.
+	for section := n.FirstChild; section != nil; section = section.Next {
+		for row := section.FirstChild; row != nil; row = row.Next {
+			tr := adf.Node{NodeType: adf.ChildNodeTableRow}
+
+			for cell := row.FirstChild; cell != nil; cell = cell.Next {
+				typ := adf.ChildNodeTableCell
+				if cell.IsHeader {
+					typ = adf.ChildNodeTableHeader
+				}
+				tr.Content = append(tr.Content, &adf.Node{
+					NodeType: typ,
+					Content:  []*adf.Node{{NodeType: adf.NodeParagraph, Content: toADFInlines(cell, nil)}},
+				})
+			}
+			table.Content = append(table.Content, &tr)
+		}
+	}
+
+	return &table
+}
+
+func toADFInlines(parent *bf.Node, marks []adf.MarkNode) []*adf.Node {
+	var out []*adf.Node
+	for n := parent.FirstChild; n != nil; n = n.Next {
+		out = append(out, toADFInline(n, marks)...)
+	}
+	return out
+}
+
+func toADFInline(n *bf.Node, marks []adf.MarkNode) []*adf.Node {
+	switch n.Type {
+	case bf.Text, bf.HTMLSpan:
+		return toADFText(string(n.Literal), marks)
+	case bf.Code:
+		// Code mark can only be combined with the link mark.
+		var codeMarks []adf.MarkNode
+		for _, m := range marks {
+			if m.MarkType == adf.MarkLink {
+				codeMarks = append(codeMarks, m)
+			}
+		}
+		return toADFText(string(n.Literal), append(codeMarks, adf.MarkNode{MarkType: adf.MarkCode}))
+	case bf.Emph:
+		return toADFInlines(n, withMark(marks, adf.MarkNode{MarkType: adf.MarkEm}))
+	case bf.Strong:
+		return toADFInlines(n, withMark(marks, adf.MarkNode{MarkType: adf.MarkStrong}))
+	case bf.Del:
+		return toADFInlines(n, withMark(marks, adf.MarkNode{MarkType: adf.MarkStrike}))
+	case bf.Link:
+		link := adf.MarkNode{MarkType: adf.MarkLink, Attributes: map[string]any{"href": string(n.Destination)}}
+		return toADFInlines(n, withMark(marks, link))
+	case bf.Image:
+		dest := string(n.Destination)
+		link := adf.MarkNode{MarkType: adf.MarkLink, Attributes: map[string]any{"href": dest}}
+
+		alt := toADFInlines(n, withMark(marks, link))
+		if len(alt) == 0 {
+			alt = toADFText(dest, withMark(marks, link))
+		}
+		return alt
+	case bf.Hardbreak, bf.Softbreak:
+		return []*adf.Node{{NodeType: adf.InlineNodeHardBreak}}
+	}
+	return toADFInlines(n, marks)
+}
+
+// toADFText translates text to text nodes preserving line breaks as hard breaks.
+func toADFText(text string, marks []adf.MarkNode) []*adf.Node {
+	var out []*adf.Node
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			out = append(out, &adf.Node{NodeType: adf.InlineNodeHardBreak})
+		}
+		if line == "" {
+			continue
+		}
+		out = append(out, &adf.Node{
+			NodeType:  adf.ChildNodeText,
+			NodeValue: adf.NodeValue{Text: line, Marks: marks},
+		})
+	}
+	return out
+}
+
+// withMark returns a copy of marks with the mark appended so
+// that sibling nodes don't share the same backing array.
+func withMark(marks []adf.MarkNode, mark adf.MarkNode) []adf.MarkNode {
+	out := make([]adf.MarkNode, 0, len(marks)+1)
+	out = append(out, marks...)
+	return append(out, mark)
+}
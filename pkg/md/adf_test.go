@@ -0,0 +1,123 @@
+package md
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+func text(t string, marks ...adf.MarkNode) *adf.Node {
+	return &adf.Node{NodeType: adf.ChildNodeText, NodeValue: adf.NodeValue{Text: t, Marks: marks}}
+}
+
+func paragraph(content ...*adf.Node) *adf.Node {
+	return &adf.Node{NodeType: adf.NodeParagraph, Content: content}
+}
+
+func listItem(content ...*adf.Node) *adf.Node {
+	return &adf.Node{NodeType: adf.ChildNodeListItem, Content: content}
+}
+
+func TestToADF(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected []*adf.Node
+	}{
+		{
+			name:     "empty",
+			input:    "  \n",
+			expected: []*adf.Node{},
+		},
+		{
+			name:  "paragraphs with line breaks",
+			input: "First line\nsecond line\n\n\nNext paragraph",
+			expected: []*adf.Node{
+				paragraph(text("First line"), &adf.Node{NodeType: adf.InlineNodeHardBreak}, text("second line")),
+				paragraph(text("Next paragraph")),
+			},
+		},
+		{
+			name:  "headings",
+			input: "# H1\n\n### H3",
+			expected: []*adf.Node{
+				{NodeType: adf.NodeHeading, Attributes: map[string]any{"level": 1}, Content: []*adf.Node{text("H1")}},
+				{NodeType: adf.NodeHeading, Attributes: map[string]any{"level": 3}, Content: []*adf.Node{text("H3")}},
+			},
+		},
+		{
+			name:  "inline marks",
+			input: "Some **bold _and italic_** text, ~~gone~~, `code` and [a link](https://example.com).",
+			expected: []*adf.Node{
+				paragraph(
+					text("Some "),
+					text("bold ", adf.MarkNode{MarkType: adf.MarkStrong}),
+					text("and italic", adf.MarkNode{MarkType: adf.MarkStrong}, adf.MarkNode{MarkType: adf.MarkEm}),
+					text(" text, "),
+					text("gone", adf.MarkNode{MarkType: adf.MarkStrike}),
+					text(", "),
+					text("code", adf.MarkNode{MarkType: adf.MarkCode}),
+					text(" and "),
+					text("a link", adf.MarkNode{MarkType: adf.MarkLink, Attributes: map[string]any{"href": "https://example.com"}}),
+					text("."),
+				),
+			},
+		},
+		{
+			name:  "fenced code block",
+			input: "```go\npackage main\n\nfunc main() {}\n```",
+			expected: []*adf.Node{
+				{
+					NodeType:   adf.NodeCodeBlock,
+					Attributes: map[string]any{"language": "go"},
+					Content:    []*adf.Node{text("package main\n\nfunc main() {}")},
+				},
+			},
+		},
+		{
+			name:  "nested lists",
+			input: "- one\n- two\n  1. two.one\n  2. two.two\n- three",
+			expected: []*adf.Node{
+				{
+					NodeType: adf.NodeBulletList,
+					Content: []*adf.Node{
+						listItem(paragraph(text("one"))),
+						listItem(
+							paragraph(text("two")),
+							&adf.Node{
+								NodeType: adf.NodeOrderedList,
+								Content: []*adf.Node{
+									listItem(paragraph(text("two.one"))),
+									listItem(paragraph(text("two.two"))),
+								},
+							},
+						),
+						listItem(paragraph(text("three"))),
+					},
+				},
+			},
+		},
+		{
+			name:  "block quote and rule",
+			input: "> quoted\n\n---",
+			expected: []*adf.Node{
+				{NodeType: adf.NodeBlockquote, Content: []*adf.Node{paragraph(text("quoted"))}},
+				{NodeType: adf.NodeRule},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := ToADF(tc.input)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, doc.Version)
+			assert.Equal(t, "doc", doc.DocType)
+			assert.Equal(t, tc.expected, doc.Content)
+		})
+	}
+}
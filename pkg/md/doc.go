@@ -1,5 +1,7 @@
 // Package md translates Jira flavored markdown to CommonMark markdown and viceversa.
+// It also translates CommonMark to Atlassian Document Format (ADF).
 //
 // See: https://jira.atlassian.com/secure/WikiRendererHelpAction.jspa?section=all
 // See: https://spec.commonmark.org/current/
+// See: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
 package md
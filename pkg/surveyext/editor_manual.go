@@ -2,15 +2,57 @@ package surveyext
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 
 	"github.com/cli/safeexec"
 	shellquote "github.com/kballard/go-shellquote"
+	"golang.org/x/term"
 )
 
+// ErrEmptyContent is returned by Edit if nothing was saved in the editor.
+var ErrEmptyContent = errors.New("aborting due to empty content")
+
+// fallbackEditors are tried in order if the configured editor is not found.
+var fallbackEditors = []string{"nano", "vi"}
+
+// Edit opens the initial value in the editor, much like git does for commit messages,
+// and returns the saved content. The editor is read from JIRA_EDITOR, VISUAL or EDITOR
+// env, falling back to nano or vi. ErrEmptyContent is returned if the saved content is
+// empty and an error is returned if the editor exits with a non-zero code, so that the
+// caller can abort.
+func Edit(initialValue string) (string, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", errors.New("editor requires an interactive terminal")
+	}
+
+	text, err := edit("", "jira*.md", initialValue, os.Stdin, os.Stdout, os.Stderr, nil, fallbackLookPath)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", ErrEmptyContent
+	}
+	return text, nil
+}
+
+func fallbackLookPath(name string) ([]string, []string, error) {
+	exe, env, err := defaultLookPath(name)
+	if err == nil {
+		return exe, env, nil
+	}
+	for _, e := range fallbackEditors {
+		if exe, env, ferr := defaultLookPath(e); ferr == nil {
+			return exe, env, nil
+		}
+	}
+	return nil, nil, err
+}
+
 type showable interface {
 	Show() error
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/cli/safeexec"
 	"github.com/gdamore/tcell/v2"
 	"github.com/mattn/go-isatty"
+	"github.com/mattn/go-runewidth"
 	"github.com/rivo/tview"
+	"golang.org/x/term"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/tui/primitive"
 )
@@ -66,6 +68,31 @@ func getActionModal() *primitive.ActionModal {
 		SetTextColor(tcell.ColorDefault)
 }
 
+// Truncate shortens the text so that it fits in the given number of terminal columns,
+// eliding the rest with "…". Wide characters, eg: CJK and emojis, take two columns.
+// The text is returned as is if width is not positive.
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	return runewidth.Truncate(s, width, "…")
+}
+
+// TextWidth returns the number of terminal columns the text takes.
+func TextWidth(s string) int {
+	return runewidth.StringWidth(s)
+}
+
+// TerminalWidth returns the number of columns of the terminal attached to
+// the stdout or 0 if the stdout is not a terminal.
+func TerminalWidth() int {
+	w, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return 0
+	}
+	return w
+}
+
 // IsDumbTerminal checks TERM/WT_SESSION environment variable and returns true if they indicate a dumb terminal.
 //
 // Dumb terminal indicates terminal with limited capability. It may not provide support
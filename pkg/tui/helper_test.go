@@ -47,6 +47,52 @@ func TestColumnPadding(t *testing.T) {
 	}
 }
 
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			name:     "it returns full string for zero width",
+			input:    "Hello, World!",
+			width:    0,
+			expected: "Hello, World!",
+		},
+		{
+			name:     "it returns full string if it fits",
+			input:    "Hello, World!",
+			width:    13,
+			expected: "Hello, World!",
+		},
+		{
+			name:     "it elides the string that doesn't fit",
+			input:    "Hello, World!",
+			width:    6,
+			expected: "Hello…",
+		},
+		{
+			name:     "it measures wide characters as two columns",
+			input:    "日本語のテキスト",
+			width:    7,
+			expected: "日本語…",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, Truncate(tc.input, tc.width))
+		})
+	}
+}
+
 func TestIsDumbTerminal(t *testing.T) {
 	// Store initial values & cleanup
 	t.Setenv("TERM", "")
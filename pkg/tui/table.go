@@ -42,6 +42,9 @@ type CopyFunc func(row, column int, data interface{})
 // CopyKeyFunc is fired when a user press 'CTRL+K' character in the table cell.
 type CopyKeyFunc func(row, column int, data interface{})
 
+// CellColorFunc returns the text color of the table cell, tcell.ColorDefault keeps the default color.
+type CellColorFunc func(row, col int, val string) tcell.Color
+
 // TableData is the data to be displayed in a table.
 type TableData [][]string
 
@@ -102,6 +105,7 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+	colorFunc    CellColorFunc
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -221,6 +225,23 @@ func WithFixedColumns(cols uint) TableOption {
 	}
 }
 
+// WithMaxColWidth sets the max width of a column, longer values are truncated.
+// Zero keeps the default width.
+func WithMaxColWidth(width uint) TableOption {
+	return func(t *Table) {
+		if width > 0 {
+			t.maxColWidth = width
+		}
+	}
+}
+
+// WithCellColorFunc sets a func that picks the text color of the table cells.
+func WithCellColorFunc(fn CellColorFunc) TableOption {
+	return func(t *Table) {
+		t.colorFunc = fn
+	}
+}
+
 // Paint paints the table layout. First row is treated as a table header.
 func (t *Table) Paint(data TableData) error {
 	if len(data) == 0 {
@@ -409,9 +430,14 @@ func renderTableCell(t *Table, data TableData) {
 
 	for r := 1; r < rows; r++ {
 		for c := 0; c < cols; c++ {
+			clr := tcell.ColorDefault
+			if t.colorFunc != nil {
+				clr = t.colorFunc(r, c, data.Get(r, c))
+			}
+
 			cell := tview.NewTableCell(pad(data.Get(r, c), t.colPad)).
 				SetMaxWidth(int(t.maxColWidth)).
-				SetTextColor(tcell.ColorDefault)
+				SetTextColor(clr)
 
 			t.view.SetCell(r, c, cell)
 		}